@@ -15,9 +15,204 @@
 
 package main
 
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
 // Bufferflow interface
 type Bufferflow interface {
 	Init()
 	OnIncomingData(data string) // implement this method
-	Close()                     // implement this method
+	// BlockUntilReady is called before each buffered write and blocks until the
+	// device can accept the data. It returns false if the data must be dropped.
+	BlockUntilReady(data []byte) bool
+	Close() // implement this method
+}
+
+// the types of the buffer parameters
+const (
+	bufferflowParamInteger = "integer"
+	bufferflowParamEnum    = "enum"
+	bufferflowParamRegexp  = "regexp"
+)
+
+// BufferflowParameter is a tunable of a buffer algorithm, set with a key=value option when opening the port
+type BufferflowParameter struct {
+	// if the name ends with .* the parameter can be given many times
+	// with different suffixes, i.e. match.ready and match.panic
+	Name        string
+	Description string
+	Type        string // integer, enum or regexp
+	Default     string
+	// the accepted values of enum parameters
+	Values []string
+	// the unit and the minimum value of integer parameters
+	Unit    string
+	Minimum int
+}
+
+// BufferflowAlgorithm describes a buffer algorithm that can be used to open a port
+type BufferflowAlgorithm struct {
+	Name        string
+	Description string
+	Parameters  []BufferflowParameter
+	// the data given to the bufferflow never ends in the middle of an UTF-8 character
+	SplitUTF8 bool `json:"-"`
+	// New creates the bufferflow of a port, the params have already been validated
+	New func(port string, output chan<- portMessage, params BufferflowParams) Bufferflow `json:"-"`
+}
+
+// BufferflowParams are the values of the parameters of a buffer algorithm
+type BufferflowParams map[string]string
+
+// bufferflows are the buffer algorithms registered by the bufferflow implementations
+var bufferflows = map[string]*BufferflowAlgorithm{}
+
+// registerBufferflow makes a buffer algorithm available, it must be called by init functions
+func registerBufferflow(algorithm *BufferflowAlgorithm) {
+	if _, exists := bufferflows[algorithm.Name]; exists {
+		panic("buffer algorithm registered twice: " + algorithm.Name)
+	}
+	bufferflows[algorithm.Name] = algorithm
+}
+
+// getBufferflow returns the buffer algorithm with the given name
+func getBufferflow(name string) (*BufferflowAlgorithm, bool) {
+	algorithm, ok := bufferflows[name]
+	return algorithm, ok
+}
+
+// bufferflowAlgorithms returns the registered buffer algorithms sorted by name
+func bufferflowAlgorithms() []*BufferflowAlgorithm {
+	res := make([]*BufferflowAlgorithm, 0, len(bufferflows))
+	for _, algorithm := range bufferflows {
+		res = append(res, algorithm)
+	}
+	slices.SortFunc(res, func(a, b *BufferflowAlgorithm) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return res
+}
+
+// bufferflowParameterName returns the name of the parameter of a registered buffer algorithm
+// given as option, ignoring the case. The suffix of a family keeps its case, i.e. match.READY.
+func bufferflowParameterName(option string) (string, bool) {
+	name := strings.ToLower(option)
+	for _, algorithm := range bufferflows {
+		param := algorithm.parameter(name)
+		if param == nil {
+			continue
+		}
+		if prefix, ok := strings.CutSuffix(param.Name, "*"); ok && len(option) > len(prefix) && strings.EqualFold(option[:len(prefix)], prefix) {
+			return prefix + option[len(prefix):], true
+		}
+		return name, true
+	}
+	return "", false
+}
+
+func (a *BufferflowAlgorithm) parameter(name string) *BufferflowParameter {
+	for i := range a.Parameters {
+		if prefix, ok := strings.CutSuffix(a.Parameters[i].Name, "*"); ok {
+			if strings.HasPrefix(name, prefix) && len(name) > len(prefix) {
+				return &a.Parameters[i]
+			}
+		} else if a.Parameters[i].Name == name {
+			return &a.Parameters[i]
+		}
+	}
+	return nil
+}
+
+// Params validates the values of the parameters and fills in the defaults
+func (a *BufferflowAlgorithm) Params(values map[string]string) (BufferflowParams, error) {
+	params := BufferflowParams{}
+	for name, value := range values {
+		param := a.parameter(name)
+		if param == nil {
+			return nil, fmt.Errorf("parameter %q is not supported by the %s buffer algorithm", name, a.Name)
+		}
+		switch param.Type {
+		case bufferflowParamEnum:
+			value = strings.ToLower(value)
+			if !slices.Contains(param.Values, value) {
+				return nil, fmt.Errorf("invalid %s %q, must be one of %s", name, value, strings.Join(param.Values, ", "))
+			}
+		case bufferflowParamRegexp:
+			if _, err := regexp.Compile(value); err != nil {
+				return nil, fmt.Errorf("invalid %s: %w", name, err)
+			}
+		default:
+			if n, err := strconv.Atoi(value); err != nil || n < param.Minimum {
+				return nil, fmt.Errorf("invalid %s %q, must be an integer not lower than %d", name, value, param.Minimum)
+			}
+		}
+		params[name] = value
+	}
+	for _, param := range a.Parameters {
+		if _, ok := params[param.Name]; !ok && !strings.HasSuffix(param.Name, "*") {
+			params[param.Name] = param.Default
+		}
+	}
+	return params, nil
+}
+
+// Int returns the value of an integer parameter
+func (p BufferflowParams) Int(name string) int {
+	n, _ := strconv.Atoi(p[name])
+	return n
+}
+
+// Family returns the values of the parameters of a family, i.e. match.*, by suffix
+func (p BufferflowParams) Family(name string) map[string]string {
+	prefix := strings.TrimSuffix(name, "*")
+	res := map[string]string{}
+	for key, value := range p {
+		if suffix, ok := strings.CutPrefix(key, prefix); ok {
+			res[suffix] = value
+		}
+	}
+	return res
+}
+
+// Milliseconds returns the value of an integer parameter expressed in milliseconds
+func (p BufferflowParams) Milliseconds(name string) time.Duration {
+	return time.Duration(p.Int(name)) * time.Millisecond
+}
+
+// bufferflowsHelp describes the buffer algorithms and their parameters for the commands help
+func bufferflowsHelp() (algorithms string, parameters string) {
+	var names, params []string
+	seen := map[string]bool{}
+	for _, algorithm := range bufferflowAlgorithms() {
+		if algorithm.Name == "default" {
+			names = append(names, "{default}")
+		} else {
+			names = append(names, algorithm.Name)
+		}
+		for _, param := range algorithm.Parameters {
+			// some parameters are shared, i.e. the interval of timed and timedraw
+			if seen[param.Name] {
+				continue
+			}
+			seen[param.Name] = true
+			name := strings.Replace(param.Name, "*", "<name>", 1)
+			switch param.Type {
+			case bufferflowParamEnum:
+				values := slices.Clone(param.Values)
+				values[slices.Index(values, param.Default)] = "{" + param.Default + "}"
+				params = append(params, "["+name+"=("+strings.Join(values, ", ")+")]")
+			case bufferflowParamRegexp:
+				params = append(params, "["+name+"=<regexp>]")
+			default:
+				params = append(params, "["+name+"=<"+param.Unit+": {"+param.Default+"}>]")
+			}
+		}
+	}
+	return strings.Join(names, ", "), strings.Join(params, " ")
 }
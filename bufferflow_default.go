@@ -21,16 +21,27 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
+func init() {
+	registerBufferflow(&BufferflowAlgorithm{
+		Name:        "default",
+		Description: "Sends the data as soon as it is read, without splitting UTF-8 characters",
+		SplitUTF8:   true,
+		New: func(port string, output chan<- portMessage, params BufferflowParams) Bufferflow {
+			return NewBufferflowDefault(port, output)
+		},
+	})
+}
+
 // BufferflowDefault is the default bufferflow, whick means no buffering
 type BufferflowDefault struct {
 	port   string
-	output chan<- []byte
+	output chan<- portMessage
 	input  chan string
 	done   chan bool
 }
 
 // NewBufferflowDefault create a new default bufferflow
-func NewBufferflowDefault(port string, output chan<- []byte) *BufferflowDefault {
+func NewBufferflowDefault(port string, output chan<- portMessage) *BufferflowDefault {
 	return &BufferflowDefault{
 		port:   port,
 		output: output,
@@ -52,7 +63,7 @@ Loop:
 		case data := <-b.input:
 			m := SpPortMessage{b.port, data}
 			message, _ := json.Marshal(m)
-			b.output <- message
+			b.output <- portMessage{b.port, eventPortData, message}
 		case <-b.done:
 			break Loop //this is required, a simple break statement would only exit the innermost switch statement
 		}
@@ -65,6 +76,11 @@ func (b *BufferflowDefault) OnIncomingData(data string) {
 	b.input <- data
 }
 
+// BlockUntilReady never blocks, the data is written as soon as possible
+func (b *BufferflowDefault) BlockUntilReady(data []byte) bool {
+	return true
+}
+
 // Close will close the bufferflow
 func (b *BufferflowDefault) Close() {
 	b.done <- true
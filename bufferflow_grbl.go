@@ -0,0 +1,184 @@
+// Copyright 2026 Arduino SA
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+)
+
+func init() {
+	registerBufferflow(&BufferflowAlgorithm{
+		Name:        "grbl",
+		Description: "Streams G-code with the character counting protocol of GRBL, realtime commands bypass the queue",
+		Parameters: []BufferflowParameter{{
+			Name:        "rxbuffer",
+			Description: "The size of the serial RX buffer of the board, minus one byte",
+			Type:        bufferflowParamInteger,
+			Default:     "127",
+			Unit:        "bytes",
+			Minimum:     1,
+		}},
+		New: func(port string, output chan<- portMessage, params BufferflowParams) Bufferflow {
+			return NewBufferflowGrbl(port, output, params.Int("rxbuffer"))
+		},
+	})
+}
+
+// grblRealtimeCommands are executed by GRBL as soon as they are received and
+// don't take space in its RX buffer: status report, feed hold, cycle start and soft reset
+const grblRealtimeCommands = "?!~\x18"
+
+// grblMaxLine is the longest answer waiting for its end, longer answers are dropped
+const grblMaxLine = 4096
+
+// BufferflowGrbl streams G-code with the character counting protocol of GRBL:
+// a line is written only when it fits in the RX buffer of the board, and the
+// space of a line is freed when GRBL answers to it with ok or error
+type BufferflowGrbl struct {
+	port     string
+	output   chan<- portMessage
+	rxBuffer int
+
+	mu   sync.Mutex
+	cond *sync.Cond
+	// sizes of the lines written and not yet answered, the oldest first.
+	// GRBL answers the lines written bypassing the queue too, so they are counted as well.
+	inFlight []int
+	// bytes written after the last newline, through the queue and bypassing it
+	partial           int
+	unbufferedPartial int
+	// the data received after the last newline
+	incoming string
+	closed   bool
+}
+
+// NewBufferflowGrbl creates a new grbl bufferflow for a board with the given RX buffer size
+func NewBufferflowGrbl(port string, output chan<- portMessage, rxBuffer int) *BufferflowGrbl {
+	b := &BufferflowGrbl{
+		port:     port,
+		output:   output,
+		rxBuffer: rxBuffer,
+	}
+	b.cond = sync.NewCond(&b.mu)
+	return b
+}
+
+// Init will initialize the bufferflow
+func (b *BufferflowGrbl) Init() {
+	log.Println("Initting grbl buffer flow (character counting of GRBL, " + strconv.Itoa(b.rxBuffer) + " bytes RX buffer)")
+}
+
+// IsRealtimeCommand tells if the data is a realtime command, that must bypass the queue
+func (b *BufferflowGrbl) IsRealtimeCommand(data string) bool {
+	return len(data) == 1 && strings.Contains(grblRealtimeCommands, data)
+}
+
+// used returns the space of the RX buffer taken by the data written, the caller must hold the lock
+func (b *BufferflowGrbl) used() int {
+	used := b.partial + b.unbufferedPartial
+	for _, n := range b.inFlight {
+		used += n
+	}
+	return used
+}
+
+// BlockUntilReady waits for the data to fit in the RX buffer of GRBL
+func (b *BufferflowGrbl) BlockUntilReady(data []byte) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	// the data is written anyway if nothing can free more space,
+	// i.e. a line longer than the buffer
+	for !b.closed && len(b.inFlight) > 0 && b.used()+len(data) > b.rxBuffer {
+		b.cond.Wait()
+	}
+	if b.closed {
+		return false
+	}
+	for _, c := range data {
+		b.partial++
+		if c == '\n' {
+			b.inFlight = append(b.inFlight, b.partial)
+			b.partial = 0
+		}
+	}
+	return true
+}
+
+// OnUnbufferedWrite counts the lines written bypassing the queue, i.e. with sendnobuf,
+// so that their answers don't free the space of the queued lines
+func (b *BufferflowGrbl) OnUnbufferedWrite(data []byte) {
+	if b.IsRealtimeCommand(string(data)) {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, c := range data {
+		b.unbufferedPartial++
+		if c == '\n' {
+			b.inFlight = append(b.inFlight, b.unbufferedPartial)
+			b.unbufferedPartial = 0
+		}
+	}
+}
+
+// OnIncomingData counts the answers of GRBL and forwards the data
+func (b *BufferflowGrbl) OnIncomingData(data string) {
+	b.mu.Lock()
+	b.incoming += data
+	for {
+		line, rest, found := strings.Cut(b.incoming, "\n")
+		if !found {
+			break
+		}
+		b.incoming = rest
+		line = strings.TrimSpace(line)
+		switch {
+		case line == "ok" || strings.HasPrefix(line, "error:"):
+			if len(b.inFlight) > 0 {
+				b.inFlight = b.inFlight[1:]
+			}
+		case strings.HasPrefix(line, "Grbl "):
+			// the board has been reset and its RX buffer is empty
+			b.inFlight = nil
+			b.partial = 0
+			b.unbufferedPartial = 0
+		default:
+			continue
+		}
+		b.cond.Broadcast()
+	}
+	if len(b.incoming) > grblMaxLine {
+		b.incoming = ""
+	}
+	b.mu.Unlock()
+
+	m := SpPortMessage{b.port, data}
+	message, _ := json.Marshal(m)
+	b.output <- portMessage{b.port, eventPortData, message}
+}
+
+// Close will close the bufferflow and release the pending writes
+func (b *BufferflowGrbl) Close() {
+	b.mu.Lock()
+	b.closed = true
+	b.cond.Broadcast()
+	b.mu.Unlock()
+}
@@ -0,0 +1,97 @@
+// Copyright 2026 Arduino SA
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBufferflowGrbl(t *testing.T) {
+	output := make(chan portMessage, 10)
+	b := NewBufferflowGrbl("/dev/ttyACM0", output, 127)
+	b.Init()
+
+	ready := func(data string) chan bool {
+		res := make(chan bool, 1)
+		go func() { res <- b.BlockUntilReady([]byte(data)) }()
+		return res
+	}
+	line := strings.Repeat("G", 59) + "\n" // 60 bytes
+
+	require.True(t, <-ready(line))
+	require.True(t, <-ready(line))
+	// a third line doesn't fit in the 127 bytes of the RX buffer
+	third := ready(line)
+	select {
+	case <-third:
+		require.Fail(t, "the write has not been throttled")
+	case <-time.After(50 * time.Millisecond):
+	}
+	// the answer may be split in several reads
+	b.OnIncomingData("o")
+	b.OnIncomingData("k\r\n")
+	require.True(t, <-third)
+	require.Equal(t, `{"P":"/dev/ttyACM0","D":"o"}`, string((<-output).data))
+	require.Equal(t, `{"P":"/dev/ttyACM0","D":"k\r\n"}`, string((<-output).data))
+
+	// errors free the space as well, alarms and status reports don't
+	b.OnIncomingData("<Idle|MPos:0.000,0.000,0.000>\r\nALARM:1\r\nerror:20\r\n")
+	b.mu.Lock()
+	require.Equal(t, []int{60}, b.inFlight)
+	b.mu.Unlock()
+
+	// the lines written bypassing the queue are answered too
+	b.OnUnbufferedWrite([]byte("?"))
+	b.OnUnbufferedWrite([]byte("$X\n"))
+	b.mu.Lock()
+	require.Equal(t, []int{60, 3}, b.inFlight)
+	b.mu.Unlock()
+	b.OnIncomingData("ok\r\n")
+	b.mu.Lock()
+	require.Equal(t, []int{3}, b.inFlight)
+	b.mu.Unlock()
+	for len(output) > 0 {
+		<-output
+	}
+
+	// an answer that never ends is dropped
+	b.OnIncomingData(strings.Repeat("x", grblMaxLine+1))
+	<-output
+	b.mu.Lock()
+	require.Empty(t, b.incoming)
+	b.mu.Unlock()
+
+	// a reset empties the RX buffer
+	b.OnIncomingData("\r\nGrbl 1.1h ['$' for help]\r\n")
+	b.mu.Lock()
+	require.Empty(t, b.inFlight)
+	b.mu.Unlock()
+
+	require.True(t, b.IsRealtimeCommand("?"))
+	require.True(t, b.IsRealtimeCommand("\x18"))
+	require.False(t, b.IsRealtimeCommand("?\n"))
+
+	// closing releases the pending writes
+	require.True(t, <-ready(line))
+	require.True(t, <-ready(line))
+	pending := ready(line)
+	b.Close()
+	require.False(t, <-pending)
+}
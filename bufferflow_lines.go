@@ -0,0 +1,155 @@
+// Copyright 2026 Arduino SA
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+func init() {
+	registerBufferflow(&BufferflowAlgorithm{
+		Name:        "lines",
+		Description: "Sends a message for each line read, with the time it has been received and a sequence number",
+		Parameters: []BufferflowParameter{{
+			Name:        "terminator",
+			Description: "The end of the lines",
+			Type:        bufferflowParamEnum,
+			Default:     "lf",
+			Values:      []string{"lf", "cr", "crlf"},
+		}, {
+			Name:        "linetimeout",
+			Description: "The idle time after which a partial line is sent, 0 to wait for the terminator",
+			Type:        bufferflowParamInteger,
+			Default:     "100",
+			Unit:        "ms",
+		}},
+		New: func(port string, output chan<- portMessage, params BufferflowParams) Bufferflow {
+			return NewBufferflowLines(port, output, lineTerminators[params["terminator"]], params.Milliseconds("linetimeout"))
+		},
+	})
+}
+
+var lineTerminators = map[string]string{
+	"lf":   "\n",
+	"cr":   "\r",
+	"crlf": "\r\n",
+}
+
+// SpPortLineMessage is the message of a line read from the serial port
+type SpPortLineMessage struct {
+	P       string    // the port, i.e. com22
+	D       string    // the line, without the terminator
+	Time    time.Time // when the end of the line has been received
+	Seq     uint64    // number of the line since the port has been opened, starting from 1
+	Partial bool      `json:",omitempty"` // the line has been sent after the idle timeout, without the terminator
+}
+
+// BufferflowLines sends a message for each line
+type BufferflowLines struct {
+	port           string
+	output         chan<- portMessage
+	input          chan string
+	done           chan bool
+	terminator     string
+	timeout        time.Duration
+	seq            uint64
+	bufferedOutput string
+}
+
+// NewBufferflowLines will create a new lines bufferflow. The lines are split on the
+// terminator, and a partial line is sent after timeout without new data if timeout > 0.
+func NewBufferflowLines(port string, output chan<- portMessage, terminator string, timeout time.Duration) *BufferflowLines {
+	return &BufferflowLines{
+		port:       port,
+		output:     output,
+		input:      make(chan string),
+		done:       make(chan bool),
+		terminator: terminator,
+		timeout:    timeout,
+	}
+}
+
+// Init will initialize the bufferflow
+func (b *BufferflowLines) Init() {
+	log.Println("Initting lines buffer flow (output once every line)")
+	go b.consumeInput()
+}
+
+func (b *BufferflowLines) consumeInput() {
+	idleTimer := time.NewTimer(b.timeout)
+	idleTimer.Stop()
+	var idle <-chan time.Time
+Loop:
+	for {
+		select {
+		case data := <-b.input:
+			now := time.Now()
+			b.bufferedOutput += data
+			for {
+				line, rest, found := strings.Cut(b.bufferedOutput, b.terminator)
+				if !found {
+					break
+				}
+				b.send(line, now, false)
+				b.bufferedOutput = rest
+			}
+			// wait for the rest of the line, but not forever
+			idle = nil
+			if b.bufferedOutput != "" && b.timeout > 0 {
+				idleTimer.Reset(b.timeout)
+				idle = idleTimer.C
+			}
+		case <-idle:
+			idle = nil
+			b.send(b.bufferedOutput, time.Now(), true)
+			b.bufferedOutput = ""
+		case <-b.done:
+			break Loop //this is required, a simple break statement would only exit the innermost switch statement
+		}
+	}
+	idleTimer.Stop()
+	if b.bufferedOutput != "" {
+		b.send(b.bufferedOutput, time.Now(), true)
+	}
+	close(b.input)
+}
+
+func (b *BufferflowLines) send(line string, received time.Time, partial bool) {
+	b.seq++
+	m := SpPortLineMessage{P: b.port, D: line, Time: received, Seq: b.seq, Partial: partial}
+	message, _ := json.Marshal(m)
+	b.output <- portMessage{b.port, eventPortLine, message}
+}
+
+// OnIncomingData will forward the data
+func (b *BufferflowLines) OnIncomingData(data string) {
+	b.input <- data
+}
+
+// BlockUntilReady never blocks, the data is written as soon as possible
+func (b *BufferflowLines) BlockUntilReady(data []byte) bool {
+	return true
+}
+
+// Close will close the bufferflow
+func (b *BufferflowLines) Close() {
+	b.done <- true
+	close(b.done)
+}
@@ -0,0 +1,73 @@
+// Copyright 2026 Arduino SA
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBufferflowLines(t *testing.T) {
+	output := make(chan portMessage, 10)
+	b := NewBufferflowLines("/dev/ttyACM0", output, "\r\n", 50*time.Millisecond)
+	b.Init()
+	next := func() SpPortLineMessage {
+		var m SpPortLineMessage
+		select {
+		case msg := <-output:
+			require.NoError(t, json.Unmarshal(msg.data, &m))
+		case <-time.After(time.Second):
+			require.Fail(t, "no line received")
+		}
+		return m
+	}
+
+	start := time.Now()
+	// the lines are reassembled across reads, the terminator too
+	b.OnIncomingData("temp=2")
+	b.OnIncomingData("1.5\r")
+	b.OnIncomingData("\nhum=40\r\nstat")
+	m := next()
+	require.Equal(t, "temp=21.5", m.D)
+	require.Equal(t, uint64(1), m.Seq)
+	require.False(t, m.Partial)
+	require.False(t, m.Time.Before(start))
+	m = next()
+	require.Equal(t, "hum=40", m.D)
+	require.Equal(t, uint64(2), m.Seq)
+
+	// a partial line is sent once the port is idle
+	m = next()
+	require.Equal(t, "stat", m.D)
+	require.Equal(t, uint64(3), m.Seq)
+	require.True(t, m.Partial)
+	require.GreaterOrEqual(t, m.Time.Sub(start), 50*time.Millisecond)
+
+	b.OnIncomingData("us=ok\r\n")
+	m = next()
+	require.Equal(t, "us=ok", m.D)
+	require.Equal(t, uint64(4), m.Seq)
+
+	// closing flushes the partial line
+	b.OnIncomingData("bye")
+	b.Close()
+	m = next()
+	require.Equal(t, "bye", m.D)
+	require.True(t, m.Partial)
+}
@@ -0,0 +1,222 @@
+// Copyright 2022 Arduino SA
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+func init() {
+	registerBufferflow(&BufferflowAlgorithm{
+		Name:        "plotter",
+		Description: "Parses the lines in the format of the Arduino Serial Plotter and sends the values read once every interval",
+		Parameters: []BufferflowParameter{bufferflowIntervalParameter, {
+			Name:        "maxrate",
+			Description: "The maximum number of samples per second sent, the others are dropped. 0 to send all of them",
+			Type:        bufferflowParamInteger,
+			Default:     "0",
+			Unit:        "samples/s",
+		}},
+		New: func(port string, output chan<- portMessage, params BufferflowParams) Bufferflow {
+			return NewBufferflowPlotter(port, output, params.Milliseconds("interval"), params.Int("maxrate"))
+		},
+	})
+}
+
+// plotterMaxLine is the longest line waiting for its end, longer lines are dropped
+const plotterMaxLine = 4096
+
+// plotterMaxReadSpan limits the time the lines of a read are spread over:
+// the lines read after a silence have been sent together
+const plotterMaxReadSpan = 100 * time.Millisecond
+
+// SpPortSeriesMessage is the message of the values read from the serial port.
+// Series[name][i] is the value read at T[i], nil if the line had no value for name.
+type SpPortSeriesMessage struct {
+	P      string                // the port, i.e. com22
+	Series map[string][]*float64 `json:"series"`
+	T      []int64               `json:"t"` // when the lines have been received, in milliseconds since the epoch
+}
+
+// parsePlotterLine parses a line in the format of the Arduino Serial Plotter:
+// values separated by spaces, tabs or commas, each one optionally labeled,
+// i.e. "temp:21.5,hum:40" or "21.5 40". Unlabeled values are named by their
+// position, i.e. value1 and value2. It returns nil if the line has no values.
+func parsePlotterLine(line string) map[string]float64 {
+	fields := strings.FieldsFunc(line, func(r rune) bool {
+		return r == ' ' || r == '\t' || r == ',' || r == '\r'
+	})
+	var values map[string]float64
+	for i, field := range fields {
+		name, value, labeled := strings.Cut(field, ":")
+		if !labeled {
+			name, value = "value"+strconv.Itoa(i+1), field
+		}
+		v, err := strconv.ParseFloat(value, 64)
+		if err != nil || name == "" {
+			continue
+		}
+		if values == nil {
+			values = map[string]float64{}
+		}
+		values[name] = v
+	}
+	return values
+}
+
+// BufferflowPlotter sends the values read once every interval
+type BufferflowPlotter struct {
+	port     string
+	output   chan<- portMessage
+	input    chan string
+	done     chan bool
+	interval time.Duration
+	ticker   *time.Ticker
+	// the minimum time between two samples, 0 to keep all of them
+	minGap     time.Duration
+	lastSample time.Time
+	lastRead   time.Time
+	// the data received after the last newline
+	incoming string
+	series   map[string][]*float64
+	t        []int64
+}
+
+// NewBufferflowPlotter will create a new plotter bufferflow. If maxRate > 0
+// at most maxRate samples per second are kept.
+func NewBufferflowPlotter(port string, output chan<- portMessage, interval time.Duration, maxRate int) *BufferflowPlotter {
+	b := &BufferflowPlotter{
+		port:     port,
+		output:   output,
+		input:    make(chan string),
+		done:     make(chan bool),
+		interval: interval,
+		ticker:   time.NewTicker(interval),
+		series:   map[string][]*float64{},
+	}
+	if maxRate > 0 {
+		b.minGap = time.Second / time.Duration(maxRate)
+	}
+	return b
+}
+
+// Init will initialize the bufferflow
+func (b *BufferflowPlotter) Init() {
+	log.Println("Initting plotter buffer flow (output once every " + strconv.FormatInt(b.interval.Milliseconds(), 10) + "ms)")
+	go b.consumeInput()
+}
+
+func (b *BufferflowPlotter) consumeInput() {
+Loop:
+	for {
+		select {
+		case data := <-b.input:
+			b.addData(time.Now(), data)
+		case <-b.ticker.C: // after the interval send the values read
+			b.flush()
+		case <-b.done:
+			break Loop //this is required, a simple break statement would only exit the innermost switch statement
+		}
+	}
+	b.flush()
+	close(b.input)
+}
+
+// addData parses the lines completed by the data received at the given time.
+// The lines of a read have arrived since the previous read, so their times are
+// spread over that span by the position of their end in the data.
+func (b *BufferflowPlotter) addData(received time.Time, data string) {
+	var span time.Duration
+	if !b.lastRead.IsZero() {
+		span = min(received.Sub(b.lastRead), plotterMaxReadSpan)
+	}
+	b.lastRead = received
+	// the position in data of the end of the lines, the first may have started in a previous read
+	end := -len(b.incoming)
+	b.incoming += data
+	for {
+		line, rest, found := strings.Cut(b.incoming, "\n")
+		if !found {
+			break
+		}
+		b.incoming = rest
+		end += len(line) + 1
+		t := received.Add(-span + span*time.Duration(end)/time.Duration(len(data)))
+		b.addSample(t, parsePlotterLine(line))
+	}
+	if len(b.incoming) > plotterMaxLine {
+		b.incoming = ""
+	}
+}
+
+// addSample appends the values of a line to the batch, keeping the series aligned with t
+func (b *BufferflowPlotter) addSample(received time.Time, values map[string]float64) {
+	if values == nil {
+		return
+	}
+	if b.minGap > 0 && received.Sub(b.lastSample) < b.minGap {
+		return
+	}
+	b.lastSample = received
+
+	n := len(b.t)
+	b.t = append(b.t, received.UnixMilli())
+	for name, value := range values {
+		s := b.series[name]
+		for len(s) < n {
+			s = append(s, nil)
+		}
+		b.series[name] = append(s, &value)
+	}
+	for name, s := range b.series {
+		if len(s) == n {
+			b.series[name] = append(s, nil)
+		}
+	}
+}
+
+func (b *BufferflowPlotter) flush() {
+	if len(b.t) == 0 {
+		return
+	}
+	m := SpPortSeriesMessage{P: b.port, Series: b.series, T: b.t}
+	message, _ := json.Marshal(m)
+	b.output <- portMessage{b.port, eventPortSeries, message}
+	b.series = map[string][]*float64{}
+	b.t = nil
+}
+
+// OnIncomingData will forward the data
+func (b *BufferflowPlotter) OnIncomingData(data string) {
+	b.input <- data
+}
+
+// BlockUntilReady never blocks, the data is written as soon as possible
+func (b *BufferflowPlotter) BlockUntilReady(data []byte) bool {
+	return true
+}
+
+// Close will close the bufferflow
+func (b *BufferflowPlotter) Close() {
+	b.ticker.Stop()
+	b.done <- true
+	close(b.done)
+}
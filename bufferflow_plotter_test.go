@@ -0,0 +1,96 @@
+// Copyright 2022 Arduino SA
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePlotterLine(t *testing.T) {
+	require.Equal(t, map[string]float64{"temp": 21.5, "hum": 40}, parsePlotterLine("temp:21.5,hum:40\r"))
+	require.Equal(t, map[string]float64{"value1": 1, "value2": -2.5, "value3": 3e3}, parsePlotterLine("1 -2.5\t3e3"))
+	require.Equal(t, map[string]float64{"x": 1}, parsePlotterLine("x:1 y:high"))
+	require.Nil(t, parsePlotterLine("Booting..."))
+	require.Nil(t, parsePlotterLine(""))
+}
+
+func TestBufferflowPlotter(t *testing.T) {
+	output := make(chan portMessage, 10)
+	// the ticker never fires, the batch is sent when the bufferflow is closed
+	b := NewBufferflowPlotter("/dev/ttyACM0", output, time.Hour, 0)
+	b.Init()
+	next := func() SpPortSeriesMessage {
+		var m SpPortSeriesMessage
+		select {
+		case msg := <-output:
+			require.NoError(t, json.Unmarshal(msg.data, &m))
+		case <-time.After(time.Second):
+			require.Fail(t, "no values received")
+		}
+		return m
+	}
+	value := func(v float64) *float64 { return &v }
+
+	start := time.Now().UnixMilli()
+	b.OnIncomingData("a:1,b:2\r\nBooting\r\na:")
+	b.OnIncomingData("3\r\nb:4,c:5\r\n")
+	b.Close()
+	m := next()
+	require.Equal(t, "/dev/ttyACM0", m.P)
+	require.Len(t, m.T, 3)
+	require.GreaterOrEqual(t, m.T[0], start)
+	// the series are aligned with the times
+	require.Equal(t, map[string][]*float64{
+		"a": {value(1), value(3), nil},
+		"b": {value(2), nil, value(4)},
+		"c": {nil, nil, value(5)},
+	}, m.Series)
+	require.Empty(t, output)
+}
+
+func TestBufferflowPlotterSpreadsTheLinesOfARead(t *testing.T) {
+	b := NewBufferflowPlotter("/dev/ttyACM0", nil, time.Second, 50)
+	defer b.ticker.Stop()
+	start := time.Now()
+	ms := func(d int64) int64 { return start.UnixMilli() + d }
+
+	b.addData(start, "x:0\n")
+	// the lines arrived in the 50ms since the previous read, one every 10ms
+	b.addData(start.Add(50*time.Millisecond), "x:1\nx:2\nx:3\nx:4\nx:5\n")
+	// one sample every 20ms
+	require.Equal(t, []int64{ms(0), ms(20), ms(40)}, b.t)
+	require.Equal(t, 4.0, *b.series["x"][2])
+
+	// the lines read after a silence have been sent together
+	b.addData(start.Add(10*time.Second), "x:6\nx:7\n")
+	require.Equal(t, []int64{ms(0), ms(20), ms(40), ms(9950), ms(10000)}, b.t)
+}
+
+func TestBufferflowPlotterMaxRate(t *testing.T) {
+	b := NewBufferflowPlotter("/dev/ttyACM0", nil, time.Second, 100)
+	defer b.ticker.Stop()
+	start := time.Now()
+	for i := 0; i < 50; i++ {
+		b.addSample(start.Add(time.Duration(i)*time.Millisecond), map[string]float64{"x": float64(i)})
+	}
+	// one sample every 10ms
+	require.Len(t, b.t, 5)
+	require.Equal(t, 30.0, *b.series["x"][3])
+}
@@ -0,0 +1,74 @@
+// Copyright 2022 Arduino SA
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBufferflowParams(t *testing.T) {
+	lines, ok := getBufferflow("lines")
+	require.True(t, ok)
+	params, err := lines.Params(map[string]string{"terminator": "crlf"})
+	require.NoError(t, err)
+	require.Equal(t, BufferflowParams{"terminator": "crlf", "linetimeout": "100"}, params)
+	require.Equal(t, 100, params.Int("linetimeout"))
+
+	_, err = lines.Params(map[string]string{"terminator": "nul"})
+	require.ErrorContains(t, err, "must be one of lf, cr, crlf")
+	_, err = lines.Params(map[string]string{"linetimeout": "-1"})
+	require.Error(t, err)
+	_, err = lines.Params(map[string]string{"interval": "10"})
+	require.ErrorContains(t, err, "not supported by the lines buffer algorithm")
+
+	timed, ok := getBufferflow("timed")
+	require.True(t, ok)
+	_, err = timed.Params(map[string]string{"interval": "0"})
+	require.Error(t, err)
+	params, err = timed.Params(nil)
+	require.NoError(t, err)
+	require.Equal(t, BufferflowParams{"interval": "16"}, params)
+
+	trigger, ok := getBufferflow("trigger")
+	require.True(t, ok)
+	params, err = trigger.Params(map[string]string{"match.ready": "^READY$", "match.panic": `Guru\sMeditation`, "data": "OFF"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"ready": "^READY$", "panic": `Guru\sMeditation`}, params.Family("match.*"))
+	require.Equal(t, "off", params["data"])
+	_, err = trigger.Params(map[string]string{"match.broken": "(unclosed"})
+	require.ErrorContains(t, err, "invalid match.broken")
+	_, err = trigger.Params(map[string]string{"match.": "READY"})
+	require.Error(t, err)
+
+	_, ok = getBufferflow("unknown")
+	require.False(t, ok)
+}
+
+func TestSpHandlerOpenUnknownBufferflow(t *testing.T) {
+	// drain the events of other tests
+	for len(h.broadcastSys) > 0 {
+		<-h.broadcastSys
+	}
+	conf := newSerialConfig("/dev/ttyACM0", 9600)
+	require.Error(t, spHandlerOpen(conf, "unknown"))
+	require.Contains(t, string((<-h.broadcastSys).encode(false)), `"Desc":"Unknown buffer algorithm unknown."`)
+
+	require.NoError(t, conf.SetOption("interval=0"))
+	require.Error(t, spHandlerOpen(conf, "timed"))
+	require.Contains(t, string((<-h.broadcastSys).encode(false)), `"Desc":"Invalid buffer parameters. invalid interval \"0\"`)
+}
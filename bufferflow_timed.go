@@ -17,30 +17,54 @@ package main
 
 import (
 	"encoding/json"
+	"strconv"
 	"time"
 
 	log "github.com/sirupsen/logrus"
 )
 
-// BufferflowTimed sends data once every 16ms
+// bufferflowIntervalParameter is the period of the timed bufferflows
+var bufferflowIntervalParameter = BufferflowParameter{
+	Name:        "interval",
+	Description: "How often the data read is sent",
+	Type:        bufferflowParamInteger,
+	Default:     "16",
+	Unit:        "ms",
+	Minimum:     1,
+}
+
+func init() {
+	registerBufferflow(&BufferflowAlgorithm{
+		Name:        "timed",
+		Description: "Sends the data read once every interval",
+		Parameters:  []BufferflowParameter{bufferflowIntervalParameter},
+		New: func(port string, output chan<- portMessage, params BufferflowParams) Bufferflow {
+			return NewBufferflowTimed(port, output, params.Milliseconds("interval"))
+		},
+	})
+}
+
+// BufferflowTimed sends data once every interval, 16ms by default
 type BufferflowTimed struct {
 	port           string
-	output         chan<- []byte
+	output         chan<- portMessage
 	input          chan string
 	done           chan bool
+	interval       time.Duration
 	ticker         *time.Ticker
 	sPort          string
 	bufferedOutput string
 }
 
 // NewBufferflowTimed will create a new timed bufferflow
-func NewBufferflowTimed(port string, output chan<- []byte) *BufferflowTimed {
+func NewBufferflowTimed(port string, output chan<- portMessage, interval time.Duration) *BufferflowTimed {
 	return &BufferflowTimed{
 		port:           port,
 		output:         output,
 		input:          make(chan string),
 		done:           make(chan bool),
-		ticker:         time.NewTicker(16 * time.Millisecond),
+		interval:       interval,
+		ticker:         time.NewTicker(interval),
 		sPort:          "",
 		bufferedOutput: "",
 	}
@@ -48,7 +72,7 @@ func NewBufferflowTimed(port string, output chan<- []byte) *BufferflowTimed {
 
 // Init will initialize the bufferflow
 func (b *BufferflowTimed) Init() {
-	log.Println("Initting timed buffer flow (output once every 16ms)")
+	log.Println("Initting timed buffer flow (output once every " + strconv.FormatInt(b.interval.Milliseconds(), 10) + "ms)")
 	go b.consumeInput()
 }
 
@@ -59,11 +83,11 @@ Loop:
 		case data := <-b.input: // use the buffer and append data to it
 			b.bufferedOutput = b.bufferedOutput + data
 			b.sPort = b.port
-		case <-b.ticker.C: // after 16ms send the buffered output message
+		case <-b.ticker.C: // after the interval send the buffered output message
 			if b.bufferedOutput != "" {
 				m := SpPortMessage{b.sPort, b.bufferedOutput}
 				buf, _ := json.Marshal(m)
-				b.output <- buf
+				b.output <- portMessage{b.port, eventPortData, buf}
 				// reset the buffer and the port
 				b.bufferedOutput = ""
 				b.sPort = ""
@@ -80,6 +104,11 @@ func (b *BufferflowTimed) OnIncomingData(data string) {
 	b.input <- data
 }
 
+// BlockUntilReady never blocks, the data is written as soon as possible
+func (b *BufferflowTimed) BlockUntilReady(data []byte) bool {
+	return true
+}
+
 // Close will close the bufferflow
 func (b *BufferflowTimed) Close() {
 	b.ticker.Stop()
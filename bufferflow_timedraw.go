@@ -17,30 +17,44 @@ package main
 
 import (
 	"encoding/json"
+	"strconv"
 	"time"
 
 	log "github.com/sirupsen/logrus"
 )
 
-// BufferflowTimedRaw sends raw data once every 16ms
+func init() {
+	registerBufferflow(&BufferflowAlgorithm{
+		Name:        "timedraw",
+		Description: "Sends the data read once every interval, base64 encoded",
+		Parameters:  []BufferflowParameter{bufferflowIntervalParameter},
+		New: func(port string, output chan<- portMessage, params BufferflowParams) Bufferflow {
+			return NewBufferflowTimedRaw(port, output, params.Milliseconds("interval"))
+		},
+	})
+}
+
+// BufferflowTimedRaw sends raw data once every interval, 16ms by default
 type BufferflowTimedRaw struct {
 	port              string
-	output            chan<- []byte
+	output            chan<- portMessage
 	input             chan string
 	done              chan bool
+	interval          time.Duration
 	ticker            *time.Ticker
 	bufferedOutputRaw []byte
 	sPortRaw          string
 }
 
 // NewBufferflowTimedRaw will create a new raw bufferflow
-func NewBufferflowTimedRaw(port string, output chan<- []byte) *BufferflowTimedRaw {
+func NewBufferflowTimedRaw(port string, output chan<- portMessage, interval time.Duration) *BufferflowTimedRaw {
 	return &BufferflowTimedRaw{
 		port:              port,
 		output:            output,
 		input:             make(chan string),
 		done:              make(chan bool),
-		ticker:            time.NewTicker(16 * time.Millisecond),
+		interval:          interval,
+		ticker:            time.NewTicker(interval),
 		bufferedOutputRaw: nil,
 		sPortRaw:          "",
 	}
@@ -48,7 +62,7 @@ func NewBufferflowTimedRaw(port string, output chan<- []byte) *BufferflowTimedRa
 
 // Init will initialize the bufferflow
 func (b *BufferflowTimedRaw) Init() {
-	log.Println("Initting timed buffer raw flow (output once every 16ms)")
+	log.Println("Initting timed buffer raw flow (output once every " + strconv.FormatInt(b.interval.Milliseconds(), 10) + "ms)")
 	go b.consumeInput()
 }
 
@@ -59,12 +73,12 @@ Loop:
 		case data := <-b.input: // use the buffer and append data to it
 			b.bufferedOutputRaw = append(b.bufferedOutputRaw, []byte(data)...)
 			b.sPortRaw = b.port
-		case <-b.ticker.C: // after 16ms send the buffered output message
+		case <-b.ticker.C: // after the interval send the buffered output message
 			if b.bufferedOutputRaw != nil {
 				m := SpPortMessageRaw{b.sPortRaw, b.bufferedOutputRaw}
 				buf, _ := json.Marshal(m)
 				// since bufferedOutputRaw is a []byte is base64-encoded by json.Marshal() function automatically
-				b.output <- buf
+				b.output <- portMessage{b.port, eventPortData, buf}
 				// reset the buffer and the port
 				b.bufferedOutputRaw = nil
 				b.sPortRaw = ""
@@ -81,6 +95,11 @@ func (b *BufferflowTimedRaw) OnIncomingData(data string) {
 	b.input <- data
 }
 
+// BlockUntilReady never blocks, the data is written as soon as possible
+func (b *BufferflowTimedRaw) BlockUntilReady(data []byte) bool {
+	return true
+}
+
 // Close will close the bufferflow
 func (b *BufferflowTimedRaw) Close() {
 	b.ticker.Stop()
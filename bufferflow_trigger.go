@@ -0,0 +1,161 @@
+// Copyright 2022 Arduino SA
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"encoding/json"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+)
+
+func init() {
+	registerBufferflow(&BufferflowAlgorithm{
+		Name:        "trigger",
+		Description: "Sends a Match message when a line read matches one of the patterns",
+		SplitUTF8:   true,
+		Parameters: []BufferflowParameter{{
+			Name:        "match.*",
+			Description: "A pattern looked for in each line, the suffix is its name. Use \\s in place of the spaces in the open command",
+			Type:        bufferflowParamRegexp,
+		}, {
+			Name:        "data",
+			Description: "Whether the data read is sent as well",
+			Type:        bufferflowParamEnum,
+			Default:     "on",
+			Values:      []string{"on", "off"},
+		}},
+		New: func(port string, output chan<- portMessage, params BufferflowParams) Bufferflow {
+			var patterns []triggerPattern
+			for name, expr := range params.Family("match.*") {
+				patterns = append(patterns, triggerPattern{name: name, re: regexp.MustCompile(expr)})
+			}
+			return NewBufferflowTrigger(port, output, patterns, params["data"] == "on")
+		},
+	})
+}
+
+// triggerMaxLine is the longest line kept waiting for its end, longer lines are matched in chunks
+const triggerMaxLine = 4096
+
+// SpPortMatch is the message sent when a line read from the port matches a pattern
+type SpPortMatch struct {
+	Cmd     string
+	Port    string
+	Pattern string            // the name of the pattern
+	Line    string            // the line, without the terminator
+	Match   string            // the text matching the pattern
+	Groups  []string          // the capture groups
+	Named   map[string]string `json:",omitempty"` // the named capture groups
+}
+
+type triggerPattern struct {
+	name string
+	re   *regexp.Regexp
+}
+
+// BufferflowTrigger looks for patterns in the lines read from the port
+type BufferflowTrigger struct {
+	port     string
+	output   chan<- portMessage
+	patterns []triggerPattern
+	sendData bool
+	// the data received after the last newline
+	incoming string
+}
+
+// NewBufferflowTrigger will create a new trigger bufferflow. If sendData is
+// true the data read is sent as well, as the default bufferflow does.
+func NewBufferflowTrigger(port string, output chan<- portMessage, patterns []triggerPattern, sendData bool) *BufferflowTrigger {
+	// the patterns are checked in a predictable order
+	slices.SortFunc(patterns, func(a, b triggerPattern) int {
+		return strings.Compare(a.name, b.name)
+	})
+	return &BufferflowTrigger{
+		port:     port,
+		output:   output,
+		patterns: patterns,
+		sendData: sendData,
+	}
+}
+
+// Init will initialize the bufferflow
+func (b *BufferflowTrigger) Init() {
+	log.Println("Initting trigger buffer flow (looking for " + strconv.Itoa(len(b.patterns)) + " patterns)")
+}
+
+// OnIncomingData forwards the data and matches the lines completed by it
+func (b *BufferflowTrigger) OnIncomingData(data string) {
+	if b.sendData {
+		m := SpPortMessage{b.port, data}
+		message, _ := json.Marshal(m)
+		b.output <- portMessage{b.port, eventPortData, message}
+	}
+
+	b.incoming += data
+	for {
+		line, rest, found := strings.Cut(b.incoming, "\n")
+		if !found {
+			break
+		}
+		b.incoming = rest
+		b.match(strings.TrimSuffix(line, "\r"))
+	}
+	if len(b.incoming) > triggerMaxLine {
+		b.match(b.incoming)
+		b.incoming = ""
+	}
+}
+
+func (b *BufferflowTrigger) match(line string) {
+	for _, pattern := range b.patterns {
+		submatches := pattern.re.FindStringSubmatch(line)
+		if submatches == nil {
+			continue
+		}
+		m := SpPortMatch{
+			Cmd:     "Match",
+			Port:    b.port,
+			Pattern: pattern.name,
+			Line:    line,
+			Match:   submatches[0],
+			Groups:  submatches[1:],
+		}
+		for i, name := range pattern.re.SubexpNames() {
+			if name == "" {
+				continue
+			}
+			if m.Named == nil {
+				m.Named = map[string]string{}
+			}
+			m.Named[name] = submatches[i]
+		}
+		message, _ := json.Marshal(m)
+		b.output <- portMessage{b.port, eventPortMatch, message}
+	}
+}
+
+// BlockUntilReady never blocks, the data is written as soon as possible
+func (b *BufferflowTrigger) BlockUntilReady(data []byte) bool {
+	return true
+}
+
+// Close will close the bufferflow
+func (b *BufferflowTrigger) Close() {
+}
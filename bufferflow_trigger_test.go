@@ -0,0 +1,46 @@
+// Copyright 2022 Arduino SA
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBufferflowTrigger(t *testing.T) {
+	output := make(chan portMessage, 10)
+	b := NewBufferflowTrigger("/dev/ttyUSB0", output, []triggerPattern{
+		{name: "ready", re: regexp.MustCompile(`^READY$`)},
+		{name: "panic", re: regexp.MustCompile(`Guru Meditation Error: Core +(?P<core>\d+) panic'ed \((\w+)\)`)},
+	}, false)
+	b.Init()
+
+	// the lines are reassembled across reads
+	b.OnIncomingData("boot\r\nREA")
+	b.OnIncomingData("DY\r\nGuru Meditation Error: Core  1 panic'ed (LoadProhibited). Exception was unhandled.\r\n")
+	require.Equal(t, `{"Cmd":"Match","Port":"/dev/ttyUSB0","Pattern":"ready","Line":"READY","Match":"READY","Groups":[]}`, string((<-output).data))
+	require.Equal(t, `{"Cmd":"Match","Port":"/dev/ttyUSB0","Pattern":"panic","Line":"Guru Meditation Error: Core  1 panic'ed (LoadProhibited). Exception was unhandled.",`+
+		`"Match":"Guru Meditation Error: Core  1 panic'ed (LoadProhibited)","Groups":["1","LoadProhibited"],"Named":{"core":"1"}}`, string((<-output).data))
+	require.Empty(t, output)
+
+	// the data can be sent as well
+	b.sendData = true
+	b.OnIncomingData("READY\n")
+	require.Equal(t, `{"P":"/dev/ttyUSB0","D":"READY\n"}`, string((<-output).data))
+	require.Contains(t, string((<-output).data), `"Cmd":"Match"`)
+}
@@ -20,12 +20,13 @@ package main
 import (
 	"bytes"
 	"crypto/rsa"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
 	"os"
 	"path/filepath"
+	"sort"
+	"strconv"
 
 	"github.com/arduino/arduino-create-agent/upload"
 	"github.com/arduino/arduino-create-agent/utilities"
@@ -40,6 +41,52 @@ type connection struct {
 
 	// Buffered channel of outbound messages.
 	send chan []byte
+
+	// The events are wrapped in an eventEnvelope, otherwise they are sent in the legacy format.
+	envelope bool
+
+	// Until the first subscribe the connection receives the data of all ports
+	// but the unsubscribed ones, then only the data of the subscribed ports.
+	// Only accessed by the hub.
+	subscribedOnly bool
+	subscriptions  map[string]bool
+	unsubscribed   map[string]bool
+}
+
+func (c *connection) subscribe(port string) {
+	if !c.subscribedOnly {
+		c.subscribedOnly = true
+		c.subscriptions = make(map[string]bool)
+		c.unsubscribed = nil
+	}
+	c.subscriptions[port] = true
+}
+
+func (c *connection) unsubscribe(port string) {
+	if c.subscribedOnly {
+		delete(c.subscriptions, port)
+		return
+	}
+	if c.unsubscribed == nil {
+		c.unsubscribed = make(map[string]bool)
+	}
+	c.unsubscribed[port] = true
+}
+
+func (c *connection) isSubscribedTo(port string) bool {
+	if c.subscribedOnly {
+		return c.subscriptions[port]
+	}
+	return !c.unsubscribed[port]
+}
+
+func (c *connection) subscribedPorts() []string {
+	ports := make([]string, 0, len(c.subscriptions))
+	for port := range c.subscriptions {
+		ports = append(ports, port)
+	}
+	sort.Strings(ports)
+	return ports
 }
 
 func (c *connection) writer() {
@@ -94,6 +141,8 @@ func uploadHandler(pubKey *rsa.PublicKey) func(*gin.Context) {
 			c.String(http.StatusBadRequest, "port is required")
 			return
 		}
+		// the port can be given by its alias
+		data.Port = portAliases.Resolve(data.Port)
 
 		if data.Board == "" {
 			c.String(http.StatusBadRequest, "board is required")
@@ -210,8 +259,7 @@ func (l PLogger) Info(args ...interface{}) {
 }
 
 func send(args map[string]string) {
-	mapB, _ := json.Marshal(args)
-	h.broadcastSys <- mapB
+	broadcastEvent(eventUpload, args)
 }
 
 func wsHandler() *WsServer {
@@ -222,9 +270,13 @@ func wsHandler() *WsServer {
 
 	server.On("connection", func(so socketio.Socket) {
 		c := &connection{send: make(chan []byte, 256*10), ws: so}
+		// the clients ask for the event envelope with the schema version, i.e. ?events=1
+		if v, err := strconv.Atoi(so.Request().URL.Query().Get("events")); err == nil && v >= 1 {
+			c.envelope = true
+		}
 		h.register <- c
 		so.On("command", func(message string) {
-			h.broadcast <- []byte(message)
+			h.broadcast <- connMessage{c, []byte(message)}
 		})
 
 		so.On("disconnection", func() {
@@ -0,0 +1,341 @@
+// Copyright 2026 Arduino SA
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package design
+
+import . "goa.design/goa/v3/dsl"
+
+var _ = Service("serial", func() {
+	Description(`The serial service manages the serial ports of the computer.
+	Port names can contain slashes, so they are passed as query parameters or in the body.`)
+
+	Error("not_found", ErrorResult, "port not found")
+	Error("invalid", ErrorResult, "invalid request")
+
+	Method("list", func() {
+		Result(CollectionOf(Port))
+		HTTP(func() {
+			GET("/serial/ports")
+			Response(StatusOK)
+		})
+	})
+
+	Method("show", func() {
+		Payload(PortName)
+		Result(Port)
+		HTTP(func() {
+			GET("/serial/port")
+			Param("name")
+			Response(StatusOK)
+			Response("not_found", StatusNotFound)
+		})
+	})
+
+	Method("state", func() {
+		Payload(PortName)
+		Result(PortState)
+		HTTP(func() {
+			GET("/serial/port/state")
+			Param("name")
+			Response(StatusOK)
+			Response("not_found", StatusNotFound)
+		})
+	})
+
+	Method("buffers", func() {
+		Result(CollectionOf(BufferAlgorithm))
+		HTTP(func() {
+			GET("/serial/buffers")
+			Response(StatusOK)
+		})
+	})
+
+	Method("open", func() {
+		Error("open_failed", ErrorResult, "the port could not be opened")
+		Payload(OpenPayload)
+		Result(Operation)
+		HTTP(func() {
+			POST("/serial/port/open")
+			Response(StatusOK)
+			Response("invalid", StatusBadRequest)
+			Response("open_failed", StatusInternalServerError)
+		})
+	})
+
+	Method("close", func() {
+		Payload(PortName)
+		Result(Operation)
+		HTTP(func() {
+			POST("/serial/port/close")
+			Response(StatusOK)
+			Response("not_found", StatusNotFound)
+		})
+	})
+
+	Method("write", func() {
+		Payload(WritePayload)
+		Result(Operation)
+		HTTP(func() {
+			POST("/serial/port/write")
+			Response(StatusOK)
+			Response("not_found", StatusNotFound)
+			Response("invalid", StatusBadRequest)
+		})
+	})
+
+	Method("aliases", func() {
+		Result(CollectionOf(Alias))
+		HTTP(func() {
+			GET("/serial/aliases")
+			Response(StatusOK)
+		})
+	})
+
+	Method("set_alias", func() {
+		Payload(AliasPayload)
+		Result(Alias)
+		HTTP(func() {
+			PUT("/serial/aliases")
+			Response(StatusOK)
+			Response("not_found", StatusNotFound)
+			Response("invalid", StatusBadRequest)
+		})
+	})
+
+	Method("remove_alias", func() {
+		Payload(func() {
+			Attribute("alias", String, "The alias to remove", func() {
+				Example("printer")
+			})
+			Required("alias")
+		})
+		Result(Operation)
+		HTTP(func() {
+			DELETE("/serial/aliases/{alias}")
+			Response(StatusOK)
+			Response("not_found", StatusNotFound)
+		})
+	})
+})
+
+var PortName = Type("arduino.serial.name", func() {
+	Description("Identifies a serial port")
+	TypeName("PortName")
+
+	Attribute("name", String, "The name of the port, or the alias of its device", func() {
+		Example("/dev/ttyACM0")
+	})
+	Required("name")
+})
+
+var OpenPayload = Type("arduino.serial.open", func() {
+	Description("The configuration used to open a serial port")
+	TypeName("OpenPayload")
+
+	Attribute("name", String, "The name of the port, or the alias of its device", func() {
+		Example("/dev/ttyACM0")
+	})
+	Attribute("baud", Int, "The baud rate", func() {
+		Minimum(1)
+		Example(9600)
+	})
+	Attribute("buffer", String, "The buffer algorithm applied to the data read from the port, see the buffers method", func() {
+		Default("default")
+		Example("timed")
+	})
+	Attribute("buffer_parameters", MapOf(String, String), "The parameters of the buffer algorithm", func() {
+		Example(map[string]string{"interval": "32"})
+	})
+	Attribute("data_bits", Int, "The number of data bits", func() {
+		Minimum(5)
+		Maximum(8)
+		Default(8)
+	})
+	Attribute("parity", String, "The parity", func() {
+		Enum("none", "odd", "even", "mark", "space")
+		Default("none")
+	})
+	Attribute("stop_bits", String, "The number of stop bits", func() {
+		Enum("1", "1.5", "2")
+		Default("1")
+	})
+	Attribute("flow_control", String, "The flow control", func() {
+		Enum("none", "rtscts", "xonxoff")
+		Default("none")
+	})
+	Attribute("auto_reconnect", Boolean, "Reopen the port when the device comes back after a reset", func() {
+		Default(false)
+	})
+
+	Required("name", "baud")
+})
+
+var WritePayload = Type("arduino.serial.write", func() {
+	Description("Data to write to an open serial port")
+	TypeName("WritePayload")
+
+	Attribute("name", String, "The name of the port, or the alias of its device", func() {
+		Example("/dev/ttyACM0")
+	})
+	Attribute("data", String, "The data to write, base64 encoded when mode is sendraw", func() {
+		Example("G0 X0\n")
+	})
+	Attribute("mode", String, "How the data is written, as the send commands of the websocket", func() {
+		Enum("send", "sendnobuf", "sendraw")
+		Default("send")
+	})
+	Attribute("id", String, `An id for the write. If present a WriteComplete message
+	is sent on the websocket once the data has been written`, func() {
+		Example("42")
+	})
+
+	Required("name", "data")
+})
+
+var AliasPayload = Type("arduino.serial.alias", func() {
+	Description("Gives an alias to the device connected to a port")
+	TypeName("AliasPayload")
+
+	Attribute("alias", String, "The alias, without spaces and slashes", func() {
+		Example("printer")
+	})
+	Attribute("name", String, "The port the device is connected to", func() {
+		Example("/dev/ttyACM0")
+	})
+
+	Required("alias", "name")
+})
+
+var Alias = ResultType("application/vnd.arduino.serial.alias", func() {
+	Description(`A stable name for a device, that keeps working when the device is replugged.
+	The device is identified by its serial number and VID/PID, or only by VID/PID if it has no serial number.`)
+	TypeName("Alias")
+
+	Attribute("alias", String, "The alias", func() {
+		Example("printer")
+	})
+	Attribute("serial_number", String, "The serial number of the device")
+	Attribute("vendor_id", String, "The USB vendor id of the device", func() {
+		Example("0x2341")
+	})
+	Attribute("product_id", String, "The USB product id of the device", func() {
+		Example("0x0043")
+	})
+
+	Required("alias", "vendor_id", "product_id")
+})
+
+var BufferAlgorithm = ResultType("application/vnd.arduino.serial.buffer", func() {
+	Description("A buffer algorithm that can be applied to the data read from a port")
+	TypeName("BufferAlgorithm")
+
+	Attribute("name", String, "The name of the algorithm", func() {
+		Example("timed")
+	})
+	Attribute("description", String, "What the algorithm does")
+	Attribute("parameters", ArrayOf(BufferParameter), "The parameters of the algorithm")
+
+	Required("name", "description", "parameters")
+})
+
+var BufferParameter = Type("arduino.serial.buffer.parameter", func() {
+	Description("A parameter of a buffer algorithm")
+	TypeName("BufferParameter")
+
+	Attribute("name", String, `The name of the parameter. If it ends with .* the parameter
+	can be given many times with different suffixes, i.e. match.ready`, func() {
+		Example("interval")
+	})
+	Attribute("description", String, "What the parameter does")
+	Attribute("type", String, "The type of the values", func() {
+		Enum("integer", "enum", "regexp")
+	})
+	Attribute("default", String, "The default value, absent for the parameters ending with .*", func() {
+		Example("16")
+	})
+	Attribute("values", ArrayOf(String), "The accepted values of an enum parameter")
+	Attribute("unit", String, "The unit of an integer parameter", func() {
+		Example("ms")
+	})
+	Attribute("minimum", Int, "The minimum value of an integer parameter")
+
+	Required("name", "description", "type")
+})
+
+var Port = ResultType("application/vnd.arduino.serial.port", func() {
+	Description("A serial port of the computer")
+	TypeName("Port")
+
+	Attribute("name", String, "The name of the port", func() {
+		Example("/dev/ttyACM0")
+	})
+	Attribute("alias", String, "The alias of the device, if any", func() {
+		Example("printer")
+	})
+	Attribute("serial_number", String, "The serial number of the device")
+	Attribute("vendor_id", String, "The USB vendor id of the device", func() {
+		Example("0x2341")
+	})
+	Attribute("product_id", String, "The USB product id of the device", func() {
+		Example("0x0043")
+	})
+	Attribute("is_open", Boolean, "Whether the port is open")
+	Attribute("baud", Int, "The baud rate, if the port is open")
+	Attribute("data_bits", Int, "The number of data bits, if the port is open")
+	Attribute("parity", String, "The parity, if the port is open")
+	Attribute("stop_bits", String, "The number of stop bits, if the port is open")
+	Attribute("flow_control", String, "The flow control, if the port is open")
+	Attribute("buffer", String, "The buffer algorithm, if the port is open")
+
+	Required("name", "is_open")
+})
+
+var PortState = ResultType("application/vnd.arduino.serial.state", func() {
+	Description("The state of an open serial port")
+	TypeName("PortState")
+
+	Attribute("name", String, "The name of the port", func() {
+		Example("/dev/ttyACM0")
+	})
+	Attribute("baud", Int, "The baud rate")
+	Attribute("data_bits", Int, "The number of data bits")
+	Attribute("parity", String, "The parity")
+	Attribute("stop_bits", String, "The number of stop bits")
+	Attribute("flow_control", String, "The flow control")
+	Attribute("buffer", String, "The buffer algorithm")
+	Attribute("auto_reconnect", Boolean, "Whether the port is reopened when the device comes back")
+	Attribute("queue_items", Int, "The number of buffered writes waiting to be sent")
+	Attribute("queue_bytes", Int64, "The size of the buffered writes waiting to be sent")
+	Attribute("recording", Boolean, "Whether the traffic of the port is being recorded")
+	Attribute("paused", Boolean, "Whether the device has paused the writes with XOFF")
+	Attribute("modem", ModemStatus, "The modem lines, absent if they can't be read")
+
+	Required("name", "baud", "data_bits", "parity", "stop_bits", "flow_control", "buffer",
+		"auto_reconnect", "queue_items", "queue_bytes", "recording", "paused")
+})
+
+var ModemStatus = Type("arduino.serial.modem", func() {
+	Description("The modem lines of a serial port")
+	TypeName("ModemStatus")
+
+	Attribute("dtr", Boolean, "Data Terminal Ready, set by the agent")
+	Attribute("rts", Boolean, "Request To Send, set by the agent")
+	Attribute("cts", Boolean, "Clear To Send")
+	Attribute("dsr", Boolean, "Data Set Ready")
+	Attribute("ri", Boolean, "Ring Indicator")
+	Attribute("dcd", Boolean, "Data Carrier Detect")
+
+	Required("dtr", "rts", "cts", "dsr", "ri", "dcd")
+})
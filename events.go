@@ -0,0 +1,163 @@
+// Copyright 2026 Arduino SA
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// eventSchemaVersion is the version of the event envelope, it is increased when
+// the envelope or the data of an event type change in an incompatible way
+const eventSchemaVersion = 1
+
+// the types of the events sent by the hub
+const (
+	eventInfo         = "info"          // Version, Hostname, OS and the help of the commands
+	eventCommand      = "command"       // echo of a text command
+	eventReply        = "reply"         // reply to a JSON command
+	eventError        = "error"         // a command failed
+	eventLog          = "log"           // the log, when enabled with log on
+	eventSubscription = "subscriptions" // the ports the connection is subscribed to
+	eventAliases      = "aliases"
+	eventPortList     = "port.list"
+	eventPortOpen     = "port.open"     // Open and SetMode
+	eventPortOpenFail = "port.openfail" // OpenFail
+	eventPortClose    = "port.close"    // Close and the shutdown of the port goroutines
+	eventPortError    = "port.error"    // read, write and break failures
+	eventPortData     = "port.data"     // the data read, see SpPortMessage
+	eventPortLine     = "port.line"     // see SpPortLineMessage
+	eventPortMatch    = "port.match"    // see SpPortMatch
+	eventPortSeries   = "port.series"   // see SpPortSeriesMessage
+	eventPortWrite    = "port.write"    // WriteComplete
+	eventPortBreak    = "port.break"
+	eventPortQueue    = "port.queue"   // the queue status and QueueCleared
+	eventPortModem    = "port.modem"   // the modem status
+	eventPortRecord   = "port.record"  // RecordStart, RecordStop and ReplayDone
+	eventPortRFC2217  = "port.rfc2217" // RFC2217 and RFC2217Stop
+	eventReconnect    = "port.reconnect"
+	eventUpload       = "upload"
+	eventDownload     = "download"
+	eventMemoryStats  = "memstats"
+	eventGC           = "gc"
+)
+
+// hubEvent is a message sent by the hub to the connections
+type hubEvent struct {
+	Type string
+	Time time.Time
+	// Data is encoded as JSON, except the strings that are sent as they are to
+	// the clients not using the envelope
+	Data interface{}
+}
+
+// eventEnvelope is the format of the events for the clients that asked for it when connecting
+type eventEnvelope struct {
+	Type    string      `json:"type"`
+	Version int         `json:"v"`
+	Time    time.Time   `json:"time"`
+	Data    interface{} `json:"data"`
+}
+
+func newEvent(typ string, data interface{}) hubEvent {
+	return hubEvent{Type: typ, Time: time.Now(), Data: data}
+}
+
+// broadcastEvent sends an event to all the connections
+func broadcastEvent(typ string, data interface{}) {
+	h.broadcastSys <- newEvent(typ, data)
+}
+
+// replyEvent sends an event only to the connection that sent the command, it
+// must not be called by the hub goroutine, that uses sendToConnection instead
+func replyEvent(c *connection, typ string, data interface{}) {
+	h.reply <- connEvent{c, newEvent(typ, data)}
+}
+
+// replyErr tells the connection that its command failed
+func replyErr(c *connection, err string) {
+	replyEvent(c, eventError, map[string]string{"Error": err})
+}
+
+// encode returns the event in the format used by the connection
+func (e hubEvent) encode(envelope bool) []byte {
+	if envelope {
+		msg, err := json.Marshal(eventEnvelope{Type: e.Type, Version: eventSchemaVersion, Time: e.Time, Data: e.Data})
+		if err != nil {
+			msg, _ = json.Marshal(eventEnvelope{Type: eventError, Version: eventSchemaVersion, Time: e.Time, Data: map[string]string{"Error": err.Error()}})
+		}
+		return msg
+	}
+	switch data := e.Data.(type) {
+	case string:
+		return []byte(data)
+	case json.RawMessage:
+		return data
+	}
+	msg, err := json.Marshal(e.Data)
+	if err != nil {
+		msg, _ = json.Marshal(map[string]string{"Error": err.Error()})
+	}
+	return msg
+}
+
+// encodedEvent caches the two encodings of an event sent to many connections
+type encodedEvent struct {
+	event            hubEvent
+	legacy, envelope []byte
+}
+
+func (e *encodedEvent) For(c *connection) []byte {
+	if c.envelope {
+		if e.envelope == nil {
+			e.envelope = e.event.encode(true)
+		}
+		return e.envelope
+	}
+	if e.legacy == nil {
+		e.legacy = e.event.encode(false)
+	}
+	return e.legacy
+}
+
+// SpPortEvent notifies a change of the state of a port
+type SpPortEvent struct {
+	Cmd  string
+	Desc string
+	Port string
+	Baud int `json:",omitempty"`
+	// the mode of the port, only for Open and SetMode
+	BufferType  string `json:",omitempty"`
+	DataBits    int    `json:",omitempty"`
+	Parity      string `json:",omitempty"`
+	StopBits    string `json:",omitempty"`
+	FlowControl string `json:",omitempty"`
+}
+
+// newSpPortModeEvent returns the event describing the mode of an open port
+func newSpPortModeEvent(cmd string, desc string, conf *SerialConfig, bufferType string) SpPortEvent {
+	return SpPortEvent{
+		Cmd:         cmd,
+		Desc:        desc,
+		Port:        conf.Name,
+		Baud:        conf.Baud,
+		BufferType:  bufferType,
+		DataBits:    conf.DataBits,
+		Parity:      conf.Parity,
+		StopBits:    conf.StopBits,
+		FlowControl: conf.FlowControl,
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright 2026 Arduino SA
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHubEventEncode(t *testing.T) {
+	now := time.Date(2026, 10, 18, 10, 0, 0, 0, time.UTC)
+
+	e := hubEvent{Type: eventError, Time: now, Data: map[string]string{"Error": `open "COM3": access denied`}}
+	require.Equal(t, `{"Error":"open \"COM3\": access denied"}`, string(e.encode(false)))
+	require.Equal(t, `{"type":"error","v":1,"time":"2026-10-18T10:00:00Z","data":{"Error":"open \"COM3\": access denied"}}`, string(e.encode(true)))
+
+	e = hubEvent{Type: eventPortClose, Time: now, Data: "Closing serial port COM3"}
+	require.Equal(t, "Closing serial port COM3", string(e.encode(false)))
+	require.Equal(t, `{"type":"port.close","v":1,"time":"2026-10-18T10:00:00Z","data":"Closing serial port COM3"}`, string(e.encode(true)))
+
+	e = hubEvent{Type: eventPortList, Time: now, Data: json.RawMessage("{\n\t\"Ports\": []\n}")}
+	require.Equal(t, "{\n\t\"Ports\": []\n}", string(e.encode(false)))
+	require.Equal(t, `{"type":"port.list","v":1,"time":"2026-10-18T10:00:00Z","data":{"Ports":[]}}`, string(e.encode(true)))
+
+	conf := newSerialConfig("COM3", 9600)
+	e = hubEvent{Type: eventPortOpen, Time: now, Data: newSpPortModeEvent("Open", "Got register/open on port.", conf, "default")}
+	require.Equal(t, `{"Cmd":"Open","Desc":"Got register/open on port.","Port":"COM3","Baud":9600,"BufferType":"default","DataBits":8,"Parity":"none","StopBits":"1","FlowControl":"none"}`, string(e.encode(false)))
+}
+
+func TestSendEventsToConnections(t *testing.T) {
+	hub := &hub{connections: make(map[*connection]bool)}
+	legacy := &connection{send: make(chan []byte, 10)}
+	envelope := &connection{send: make(chan []byte, 10), envelope: true}
+	hub.connections[legacy] = true
+	hub.connections[envelope] = true
+
+	hub.sendToRegisteredConnections(newEvent(eventCommand, "list"))
+	hub.sendToPortSubscribers(portMessage{"/dev/ttyACM0", eventPortData, []byte(`{"P":"/dev/ttyACM0","D":"ok\n"}`)})
+
+	require.Equal(t, []string{"list", `{"P":"/dev/ttyACM0","D":"ok\n"}`}, drain(legacy.send))
+	msgs := drain(envelope.send)
+	require.Len(t, msgs, 2)
+	var command, data eventEnvelope
+	require.NoError(t, json.Unmarshal([]byte(msgs[0]), &command))
+	require.NoError(t, json.Unmarshal([]byte(msgs[1]), &data))
+	require.Equal(t, eventCommand, command.Type)
+	require.Equal(t, eventSchemaVersion, command.Version)
+	require.Equal(t, "list", command.Data)
+	require.Equal(t, eventPortData, data.Type)
+	require.Equal(t, map[string]interface{}{"P": "/dev/ttyACM0", "D": "ok\n"}, data.Data)
+	require.WithinDuration(t, time.Now(), data.Time, time.Minute)
+}
@@ -13,6 +13,7 @@ import (
 	"net/http"
 	"os"
 
+	serialc "github.com/arduino/arduino-create-agent/gen/http/serial/client"
 	toolsc "github.com/arduino/arduino-create-agent/gen/http/tools/client"
 	goahttp "goa.design/goa/v3/http"
 	goa "goa.design/goa/v3/pkg"
@@ -23,12 +24,14 @@ import (
 //	command (subcommand1|subcommand2|...)
 func UsageCommands() string {
 	return `tools (available|installedhead|installed|install|remove)
+serial (list|show|state|buffers|open|close|write|aliases|set-alias|remove-alias)
 `
 }
 
 // UsageExamples produces an example of a valid invocation of the CLI tool.
 func UsageExamples() string {
 	return os.Args[0] + ` tools available` + "\n" +
+		os.Args[0] + ` serial list` + "\n" +
 		""
 }
 
@@ -58,6 +61,35 @@ func ParseEndpoint(
 		toolsRemovePackagerFlag = toolsRemoveFlags.String("packager", "REQUIRED", "The packager of the tool")
 		toolsRemoveNameFlag     = toolsRemoveFlags.String("name", "REQUIRED", "The name of the tool")
 		toolsRemoveVersionFlag  = toolsRemoveFlags.String("version", "REQUIRED", "The version of the tool")
+
+		serialFlags = flag.NewFlagSet("serial", flag.ContinueOnError)
+
+		serialListFlags = flag.NewFlagSet("list", flag.ExitOnError)
+
+		serialShowFlags    = flag.NewFlagSet("show", flag.ExitOnError)
+		serialShowNameFlag = serialShowFlags.String("name", "REQUIRED", "")
+
+		serialStateFlags    = flag.NewFlagSet("state", flag.ExitOnError)
+		serialStateNameFlag = serialStateFlags.String("name", "REQUIRED", "")
+
+		serialBuffersFlags = flag.NewFlagSet("buffers", flag.ExitOnError)
+
+		serialOpenFlags    = flag.NewFlagSet("open", flag.ExitOnError)
+		serialOpenBodyFlag = serialOpenFlags.String("body", "REQUIRED", "")
+
+		serialCloseFlags    = flag.NewFlagSet("close", flag.ExitOnError)
+		serialCloseBodyFlag = serialCloseFlags.String("body", "REQUIRED", "")
+
+		serialWriteFlags    = flag.NewFlagSet("write", flag.ExitOnError)
+		serialWriteBodyFlag = serialWriteFlags.String("body", "REQUIRED", "")
+
+		serialAliasesFlags = flag.NewFlagSet("aliases", flag.ExitOnError)
+
+		serialSetAliasFlags    = flag.NewFlagSet("set-alias", flag.ExitOnError)
+		serialSetAliasBodyFlag = serialSetAliasFlags.String("body", "REQUIRED", "")
+
+		serialRemoveAliasFlags     = flag.NewFlagSet("remove-alias", flag.ExitOnError)
+		serialRemoveAliasAliasFlag = serialRemoveAliasFlags.String("alias", "REQUIRED", "The alias to remove")
 	)
 	toolsFlags.Usage = toolsUsage
 	toolsAvailableFlags.Usage = toolsAvailableUsage
@@ -66,6 +98,18 @@ func ParseEndpoint(
 	toolsInstallFlags.Usage = toolsInstallUsage
 	toolsRemoveFlags.Usage = toolsRemoveUsage
 
+	serialFlags.Usage = serialUsage
+	serialListFlags.Usage = serialListUsage
+	serialShowFlags.Usage = serialShowUsage
+	serialStateFlags.Usage = serialStateUsage
+	serialBuffersFlags.Usage = serialBuffersUsage
+	serialOpenFlags.Usage = serialOpenUsage
+	serialCloseFlags.Usage = serialCloseUsage
+	serialWriteFlags.Usage = serialWriteUsage
+	serialAliasesFlags.Usage = serialAliasesUsage
+	serialSetAliasFlags.Usage = serialSetAliasUsage
+	serialRemoveAliasFlags.Usage = serialRemoveAliasUsage
+
 	if err := flag.CommandLine.Parse(os.Args[1:]); err != nil {
 		return nil, nil, err
 	}
@@ -83,6 +127,8 @@ func ParseEndpoint(
 		switch svcn {
 		case "tools":
 			svcf = toolsFlags
+		case "serial":
+			svcf = serialFlags
 		default:
 			return nil, nil, fmt.Errorf("unknown service %q", svcn)
 		}
@@ -117,6 +163,40 @@ func ParseEndpoint(
 
 			}
 
+		case "serial":
+			switch epn {
+			case "list":
+				epf = serialListFlags
+
+			case "show":
+				epf = serialShowFlags
+
+			case "state":
+				epf = serialStateFlags
+
+			case "buffers":
+				epf = serialBuffersFlags
+
+			case "open":
+				epf = serialOpenFlags
+
+			case "close":
+				epf = serialCloseFlags
+
+			case "write":
+				epf = serialWriteFlags
+
+			case "aliases":
+				epf = serialAliasesFlags
+
+			case "set-alias":
+				epf = serialSetAliasFlags
+
+			case "remove-alias":
+				epf = serialRemoveAliasFlags
+
+			}
+
 		}
 	}
 	if epf == nil {
@@ -156,6 +236,40 @@ func ParseEndpoint(
 				endpoint = c.Remove()
 				data, err = toolsc.BuildRemovePayload(*toolsRemoveBodyFlag, *toolsRemovePackagerFlag, *toolsRemoveNameFlag, *toolsRemoveVersionFlag)
 			}
+		case "serial":
+			c := serialc.NewClient(scheme, host, doer, enc, dec, restore)
+			switch epn {
+			case "list":
+				endpoint = c.List()
+				data = nil
+			case "show":
+				endpoint = c.Show()
+				data, err = serialc.BuildShowPayload(*serialShowNameFlag)
+			case "state":
+				endpoint = c.State()
+				data, err = serialc.BuildStatePayload(*serialStateNameFlag)
+			case "buffers":
+				endpoint = c.Buffers()
+				data = nil
+			case "open":
+				endpoint = c.Open()
+				data, err = serialc.BuildOpenPayload(*serialOpenBodyFlag)
+			case "close":
+				endpoint = c.Close()
+				data, err = serialc.BuildClosePayload(*serialCloseBodyFlag)
+			case "write":
+				endpoint = c.Write()
+				data, err = serialc.BuildWritePayload(*serialWriteBodyFlag)
+			case "aliases":
+				endpoint = c.Aliases()
+				data = nil
+			case "set-alias":
+				endpoint = c.SetAlias()
+				data, err = serialc.BuildSetAliasPayload(*serialSetAliasBodyFlag)
+			case "remove-alias":
+				endpoint = c.RemoveAlias()
+				data, err = serialc.BuildRemoveAliasPayload(*serialRemoveAliasAliasFlag)
+			}
 		}
 	}
 	if err != nil {
@@ -247,3 +361,155 @@ Example:
    }' --packager "arduino" --name "bossac" --version "1.7.0-arduino3"
 `, os.Args[0])
 }
+
+// serialUsage displays the usage of the serial command and its subcommands.
+func serialUsage() {
+	fmt.Fprintf(os.Stderr, `The serial service manages the serial ports of the computer.
+		Port names can contain slashes, so they are passed as query parameters or in the body.
+Usage:
+    %[1]s [globalflags] serial COMMAND [flags]
+
+COMMAND:
+    list: List implements list.
+    show: Show implements show.
+    state: State implements state.
+    buffers: Buffers implements buffers.
+    open: Open implements open.
+    close: Close implements close.
+    write: Write implements write.
+    aliases: Aliases implements aliases.
+    set-alias: SetAlias implements set_alias.
+    remove-alias: RemoveAlias implements remove_alias.
+
+Additional help:
+    %[1]s serial COMMAND --help
+`, os.Args[0])
+}
+func serialListUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] serial list
+
+List implements list.
+
+Example:
+    %[1]s serial list
+`, os.Args[0])
+}
+
+func serialShowUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] serial show -name STRING
+
+Show implements show.
+    -name STRING: 
+
+Example:
+    %[1]s serial show --name "/dev/ttyACM0"
+`, os.Args[0])
+}
+
+func serialStateUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] serial state -name STRING
+
+State implements state.
+    -name STRING: 
+
+Example:
+    %[1]s serial state --name "/dev/ttyACM0"
+`, os.Args[0])
+}
+
+func serialBuffersUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] serial buffers
+
+Buffers implements buffers.
+
+Example:
+    %[1]s serial buffers
+`, os.Args[0])
+}
+
+func serialOpenUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] serial open -body JSON
+
+Open implements open.
+    -body JSON: 
+
+Example:
+    %[1]s serial open --body '{
+      "auto_reconnect": true,
+      "baud": 9600,
+      "buffer": "timed",
+      "buffer_parameters": {
+         "interval": "32"
+      },
+      "data_bits": 7,
+      "flow_control": "none",
+      "name": "/dev/ttyACM0",
+      "parity": "space",
+      "stop_bits": "1"
+   }'
+`, os.Args[0])
+}
+
+func serialCloseUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] serial close -body JSON
+
+Close implements close.
+    -body JSON: 
+
+Example:
+    %[1]s serial close --body '{
+      "name": "/dev/ttyACM0"
+   }'
+`, os.Args[0])
+}
+
+func serialWriteUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] serial write -body JSON
+
+Write implements write.
+    -body JSON: 
+
+Example:
+    %[1]s serial write --body '{
+      "data": "G0 X0\n",
+      "id": "42",
+      "mode": "sendraw",
+      "name": "/dev/ttyACM0"
+   }'
+`, os.Args[0])
+}
+
+func serialAliasesUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] serial aliases
+
+Aliases implements aliases.
+
+Example:
+    %[1]s serial aliases
+`, os.Args[0])
+}
+
+func serialSetAliasUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] serial set-alias -body JSON
+
+SetAlias implements set_alias.
+    -body JSON: 
+
+Example:
+    %[1]s serial set-alias --body '{
+      "alias": "printer",
+      "name": "/dev/ttyACM0"
+   }'
+`, os.Args[0])
+}
+
+func serialRemoveAliasUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] serial remove-alias -alias STRING
+
+RemoveAlias implements remove_alias.
+    -alias STRING: The alias to remove
+
+Example:
+    %[1]s serial remove-alias --alias "printer"
+`, os.Args[0])
+}
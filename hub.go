@@ -35,10 +35,16 @@ type hub struct {
 	connections map[*connection]bool
 
 	// Inbound messages from the connections.
-	broadcast chan []byte
+	broadcast chan connMessage
 
 	// Inbound messages from the system
-	broadcastSys chan []byte
+	broadcastSys chan hubEvent
+
+	// Inbound messages from the serial ports
+	broadcastPort chan portMessage
+
+	// Outbound messages for a single connection, i.e. the replies to its commands
+	reply chan connEvent
 
 	// Register requests from the connections.
 	register chan *connection
@@ -47,20 +53,54 @@ type hub struct {
 	unregister chan *connection
 }
 
+// connMessage is a command received from a connection
+type connMessage struct {
+	conn *connection
+	data []byte
+}
+
+// connEvent is an event for a single connection
+type connEvent struct {
+	conn  *connection
+	event hubEvent
+}
+
+// portMessage is the data received from a serial port, it is delivered
+// only to the connections subscribed to the port
+type portMessage struct {
+	port string
+	typ  string // the type of the event, i.e. eventPortData
+	data []byte // JSON
+}
+
 var h = hub{
-	broadcast:    make(chan []byte, 1000),
-	broadcastSys: make(chan []byte, 1000),
-	register:     make(chan *connection),
-	unregister:   make(chan *connection),
-	connections:  make(map[*connection]bool),
+	broadcast:     make(chan connMessage, 1000),
+	broadcastSys:  make(chan hubEvent, 1000),
+	broadcastPort: make(chan portMessage, 1000),
+	reply:         make(chan connEvent, 1000),
+	register:      make(chan *connection),
+	unregister:    make(chan *connection),
+	connections:   make(map[*connection]bool),
 }
 
+// commands is the help of the commands, see commandsHelp
 const commands = `{
   "Commands": [
     "list",
-    "open <portName> <baud> [bufferAlgorithm: ({default}, timed, timedraw)]",
-    "(send, sendnobuf, sendraw) <portName> <cmd>",
+    "open <portName | replay:recordFile> <baud> [bufferAlgorithm: (%s)] [databits=({8}, 7, 6, 5)] [parity=({none}, odd, even, mark, space)] [stopbits=({1}, 1.5, 2)] [flowcontrol=({none}, rtscts, xonxoff)] [autoreconnect=({off}, on)] [rfc2217=<tcpPort>] %s",
+    "(send, sendnobuf, sendraw)[:<id>] <portName> <cmd>",
     "close <portName>",
+    "(queue, clearqueue) <portName>",
+    "(subscribe, unsubscribe) <portName>",
+    "setmode <portName> <baud> [databits=<bits>] [parity=<parity>] [stopbits=<bits>]",
+    "(setdtr, setrts) <portName> <on|off>",
+    "break <portName> <milliseconds>",
+    "record (start, stop) <portName>",
+    "modemstatus <portName> [watch|unwatch]",
+    "rfc2217 <portName> <tcpPort|off>",
+    "alias <portName> <alias>",
+    "unalias <alias>",
+    "aliases",
     "restart",
     "exit",
     "killupload",
@@ -69,10 +109,17 @@ const commands = `{
     "memorystats",
     "gc",
     "hostname",
-    "version"
+    "version",
+    "{\"id\": <id>, \"method\": \"<command>\", \"params\": {<name>: <value>}}"
   ]
 }`
 
+// commandsHelp returns the help of the commands, with the registered buffer algorithms
+func commandsHelp() string {
+	algorithms, parameters := bufferflowsHelp()
+	return fmt.Sprintf(commands, algorithms, parameters)
+}
+
 func (h *hub) unregisterConnection(c *connection) {
 	if _, contains := h.connections[c]; !contains {
 		return
@@ -81,10 +128,11 @@ func (h *hub) unregisterConnection(c *connection) {
 	close(c.send)
 }
 
-func (h *hub) sendToRegisteredConnections(data []byte) {
+func (h *hub) sendToRegisteredConnections(e hubEvent) {
+	enc := encodedEvent{event: e}
 	for c := range h.connections {
 		select {
-		case c.send <- data:
+		case c.send <- enc.For(c):
 			//log.Print("did broadcast to ")
 			//log.Print(c.ws.RemoteAddr())
 			//c.send <- []byte("hello world")
@@ -94,30 +142,63 @@ func (h *hub) sendToRegisteredConnections(data []byte) {
 	}
 }
 
+func (h *hub) sendToPortSubscribers(m portMessage) {
+	enc := encodedEvent{event: newEvent(m.typ, json.RawMessage(m.data))}
+	for c := range h.connections {
+		if !c.isSubscribedTo(m.port) {
+			continue
+		}
+		select {
+		case c.send <- enc.For(c):
+		default:
+			h.unregisterConnection(c)
+		}
+	}
+}
+
+func (h *hub) sendToConnection(c *connection, e hubEvent) {
+	if _, contains := h.connections[c]; !contains {
+		return
+	}
+	select {
+	case c.send <- e.encode(c.envelope):
+	default:
+		h.unregisterConnection(c)
+	}
+}
+
 func (h *hub) run() {
 	for {
 		select {
 		case c := <-h.register:
 			h.connections[c] = true
 			// send supported commands
-			c.send <- []byte(fmt.Sprintf(`{"Version" : "%s"} `, version))
-			c.send <- []byte(html.EscapeString(commands))
-			c.send <- []byte(fmt.Sprintf(`{"Hostname" : "%s"} `, *hostname))
-			c.send <- []byte(fmt.Sprintf(`{"OS" : "%s"} `, runtime.GOOS))
+			h.sendToConnection(c, newEvent(eventInfo, map[string]string{"Version": version}))
+			h.sendToConnection(c, newEvent(eventInfo, html.EscapeString(commandsHelp())))
+			h.sendToConnection(c, newEvent(eventInfo, map[string]string{"Hostname": *hostname}))
+			h.sendToConnection(c, newEvent(eventInfo, map[string]string{"OS": runtime.GOOS}))
 		case c := <-h.unregister:
 			h.unregisterConnection(c)
 		case m := <-h.broadcast:
-			if len(m) > 0 {
-				checkCmd(m)
-				h.sendToRegisteredConnections(m)
+			if isRPCRequest(m.data) {
+				// the JSON commands get a reply instead of being echoed to everyone
+				h.handleRPC(m.conn, m.data)
+			} else if len(m.data) > 0 {
+				checkCmd(m.conn, m.data)
+				// only the sender gets the echo, the other clients get the events of the command
+				h.sendToConnection(m.conn, newEvent(eventCommand, string(m.data)))
 			}
+		case m := <-h.reply:
+			h.sendToConnection(m.conn, m.event)
 		case m := <-h.broadcastSys:
 			h.sendToRegisteredConnections(m)
+		case m := <-h.broadcastPort:
+			h.sendToPortSubscribers(m)
 		}
 	}
 }
 
-func checkCmd(m []byte) {
+func checkCmd(c *connection, m []byte) {
 	//log.Print("Inside checkCmd")
 	s := string(m[:])
 
@@ -132,52 +213,86 @@ func checkCmd(m []byte) {
 
 		args := strings.Split(s, " ")
 		if len(args) < 3 {
-			go spErr("You did not specify a port and baud rate in your open cmd")
+			go replyErr(c, "You did not specify a port and baud rate in your open cmd")
 			return
 		}
 		if len(args[1]) < 1 {
-			go spErr("You did not specify a serial port")
+			go replyErr(c, "You did not specify a serial port")
 			return
 		}
 
 		baudStr := strings.Replace(args[2], "\n", "", -1)
 		baud, err := strconv.Atoi(baudStr)
 		if err != nil {
-			go spErr("Problem converting baud rate " + args[2])
+			go replyErr(c, "Problem converting baud rate "+args[2])
 			return
 		}
+		conf := newSerialConfig(args[1], baud)
 		// pass in buffer type now as string. if user does not
 		// ask for a buffer type pass in empty string
 		bufferAlgorithm := "default" // use the default buffer if none is specified
-		if len(args) > 3 {
+		options := args[3:]
+		if len(options) > 0 && !strings.Contains(options[0], "=") {
 			// cool. we got a buffer type request
-			buftype := strings.Replace(args[3], "\n", "", -1)
+			buftype := strings.Replace(options[0], "\n", "", -1)
 			bufferAlgorithm = buftype
+			options = options[1:]
+		}
+		// the remaining arguments are key=value options, i.e. parity=even
+		for _, option := range options {
+			option = strings.Replace(option, "\n", "", -1)
+			if option == "" {
+				continue
+			}
+			if err := conf.SetOption(option); err != nil {
+				go spOpenFail(conf, "Invalid port configuration. "+err.Error())
+				return
+			}
 		}
-		go spHandlerOpen(args[1], baud, bufferAlgorithm)
+		go spHandlerOpen(conf, bufferAlgorithm)
 
+	} else if strings.HasPrefix(sl, "subscribe") || strings.HasPrefix(sl, "unsubscribe") {
+		// subscriptions belong to the connection, so they are handled
+		// synchronously by the hub goroutine
+		h.subscribe(c, s)
 	} else if strings.HasPrefix(sl, "close") {
 
 		args := strings.Split(s, " ")
 		if len(args) > 1 {
-			go spClose(args[1])
+			go spClose(c, args[1])
 		} else {
-			go spErr("You did not specify a port to close")
+			go replyErr(c, "You did not specify a port to close")
 		}
 
 	} else if strings.HasPrefix(sl, "killupload") {
 		// kill the running process (assumes singleton for now)
 		go func() {
 			upload.Kill()
-			h.broadcastSys <- []byte("{\"uploadStatus\": \"Killed\"}")
+			broadcastEvent(eventUpload, map[string]string{"uploadStatus": "Killed"})
 			log.Println("{\"uploadStatus\": \"Killed\"}")
 		}()
 
 	} else if strings.HasPrefix(sl, "send") {
 		// will catch send and sendnobuf and sendraw
-		go spWrite(s)
+		go spWrite(c, s)
+	} else if strings.HasPrefix(sl, "queue") || strings.HasPrefix(sl, "clearqueue") {
+		go spQueue(c, s)
+	} else if strings.HasPrefix(sl, "record") {
+		go spRecord(c, s)
+	} else if strings.HasPrefix(sl, "break") {
+		go spBreak(c, s)
+	} else if strings.HasPrefix(sl, "setmode") {
+		go spSetMode(c, s)
+	} else if strings.HasPrefix(sl, "setdtr") || strings.HasPrefix(sl, "setrts") {
+		go spSetModemLine(c, s)
+	} else if strings.HasPrefix(sl, "modemstatus") {
+		go spModemStatus(c, s)
+	} else if strings.HasPrefix(sl, "rfc2217") {
+		go spRFC2217(c, s)
+	} else if strings.HasPrefix(sl, "alias") || strings.HasPrefix(sl, "unalias") {
+		go spAlias(c, s)
 	} else if strings.HasPrefix(sl, "list") {
-		go serialPorts.List()
+		go serialPorts.ListTo(c)
 	} else if strings.HasPrefix(sl, "downloadtool") {
 		go func() {
 			args := strings.Split(s, " ")
@@ -186,9 +301,7 @@ func checkCmd(m []byte) {
 			pack = "arduino"
 			behaviour = "keep"
 			if len(args) <= 1 {
-				mapD := map[string]string{"DownloadStatus": "Error", "Msg": "Not enough arguments"}
-				mapB, _ := json.Marshal(mapD)
-				h.broadcastSys <- mapB
+				broadcastEvent(eventDownload, map[string]string{"DownloadStatus": "Error", "Msg": "Not enough arguments"})
 				return
 			}
 			if len(args) > 1 {
@@ -210,13 +323,9 @@ func checkCmd(m []byte) {
 
 			err := Tools.Download(pack, tool, toolVersion, behaviour)
 			if err != nil {
-				mapD := map[string]string{"DownloadStatus": "Error", "Msg": err.Error()}
-				mapB, _ := json.Marshal(mapD)
-				h.broadcastSys <- mapB
+				broadcastEvent(eventDownload, map[string]string{"DownloadStatus": "Error", "Msg": err.Error()})
 			} else {
-				mapD := map[string]string{"DownloadStatus": "Success", "Msg": "Map Updated"}
-				mapB, _ := json.Marshal(mapD)
-				h.broadcastSys <- mapB
+				broadcastEvent(eventDownload, map[string]string{"DownloadStatus": "Success", "Msg": "Map Updated"})
 			}
 		}()
 	} else if strings.HasPrefix(sl, "log") {
@@ -227,18 +336,37 @@ func checkCmd(m []byte) {
 	} else if strings.HasPrefix(sl, "exit") {
 		Systray.Quit()
 	} else if strings.HasPrefix(sl, "memstats") {
-		memoryStats()
+		memoryStats(c)
 	} else if strings.HasPrefix(sl, "gc") {
-		garbageCollection()
+		garbageCollection(c)
 	} else if strings.HasPrefix(sl, "hostname") {
-		getHostname()
+		getHostname(c)
 	} else if strings.HasPrefix(sl, "version") {
-		getVersion()
+		getVersion(c)
 	} else {
-		go spErr("Could not understand command.")
+		go replyErr(c, "Could not understand command.")
 	}
 }
 
+func (h *hub) subscribe(c *connection, arg string) {
+	args := strings.Fields(arg)
+	if len(args) != 2 {
+		h.sendToConnection(c, newEvent(eventError, map[string]string{"Error": "You did not specify a port to " + strings.ToLower(args[0])}))
+		return
+	}
+	// the port data is sent with the port name, not its alias
+	port := portAliases.Resolve(args[1])
+	if strings.ToLower(args[0]) == "subscribe" {
+		c.subscribe(port)
+	} else {
+		c.unsubscribe(port)
+	}
+	h.sendToConnection(c, newEvent(eventSubscription, map[string]interface{}{
+		"Cmd":           "Subscriptions",
+		"Subscriptions": c.subscribedPorts(),
+	}))
+}
+
 func logAction(sl string) {
 	if strings.HasPrefix(sl, "log on") {
 		*logDump = "on"
@@ -253,30 +381,33 @@ func logAction(sl string) {
 	}
 }
 
-func memoryStats() {
+// the replies of the commands below are sent only to the connection that asked,
+// they run in the hub goroutine
+
+func memoryStats(c *connection) {
 	var memStats runtime.MemStats
 	runtime.ReadMemStats(&memStats)
 	json, _ := json.Marshal(memStats)
 	log.Printf("memStats:%v\n", string(json))
-	h.broadcastSys <- json
+	h.sendToConnection(c, newEvent(eventMemoryStats, memStats))
 }
 
-func getHostname() {
-	h.broadcastSys <- []byte("{\"Hostname\" : \"" + *hostname + "\"}")
+func getHostname(c *connection) {
+	h.sendToConnection(c, newEvent(eventInfo, map[string]string{"Hostname": *hostname}))
 }
 
-func getVersion() {
-	h.broadcastSys <- []byte("{\"Version\" : \"" + version + "\"}")
+func getVersion(c *connection) {
+	h.sendToConnection(c, newEvent(eventInfo, map[string]string{"Version": version}))
 }
 
-func garbageCollection() {
+func garbageCollection(c *connection) {
 	log.Printf("Starting garbageCollection()\n")
-	h.broadcastSys <- []byte("{\"gc\":\"starting\"}")
-	memoryStats()
+	h.sendToConnection(c, newEvent(eventGC, map[string]string{"gc": "starting"}))
+	memoryStats(c)
 	debug.SetGCPercent(100)
 	debug.FreeOSMemory()
 	debug.SetGCPercent(-1)
 	log.Printf("Done with garbageCollection()\n")
-	h.broadcastSys <- []byte("{\"gc\":\"done\"}")
-	memoryStats()
+	h.sendToConnection(c, newEvent(eventGC, map[string]string{"gc": "done"}))
+	memoryStats(c)
 }
@@ -0,0 +1,100 @@
+// Copyright 2026 Arduino SA
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendToPortSubscribers(t *testing.T) {
+	hub := &hub{connections: make(map[*connection]bool)}
+	all := &connection{send: make(chan []byte, 10)}
+	acm0 := &connection{send: make(chan []byte, 10)}
+	none := &connection{send: make(chan []byte, 10)}
+	noAcm1 := &connection{send: make(chan []byte, 10)}
+	for _, c := range []*connection{all, acm0, none, noAcm1} {
+		hub.connections[c] = true
+	}
+	acm0.subscribe("/dev/ttyACM0")
+	acm0.subscribe("/dev/ttyACM1")
+	acm0.unsubscribe("/dev/ttyACM1")
+	none.subscribe("/dev/ttyACM1")
+	none.unsubscribe("/dev/ttyACM1")
+	// unsubscribe without a subscribe stops only the data of that port
+	noAcm1.unsubscribe("/dev/ttyACM1")
+
+	hub.sendToPortSubscribers(portMessage{"/dev/ttyACM0", eventPortData, []byte("acm0")})
+	hub.sendToPortSubscribers(portMessage{"/dev/ttyACM1", eventPortData, []byte("acm1")})
+	hub.sendToRegisteredConnections(newEvent(eventLog, "sys"))
+
+	require.Equal(t, []string{"acm0", "acm1", "sys"}, drain(all.send))
+	require.Equal(t, []string{"acm0", "sys"}, drain(acm0.send))
+	require.Equal(t, []string{"sys"}, drain(none.send))
+	require.Equal(t, []string{"acm0", "sys"}, drain(noAcm1.send))
+	require.Equal(t, []string{"/dev/ttyACM0"}, acm0.subscribedPorts())
+}
+
+// drainEvents returns the events in the legacy format
+func drainEvents(ch chan hubEvent) []string {
+	var msgs []string
+	for {
+		select {
+		case e := <-ch:
+			msgs = append(msgs, string(e.encode(false)))
+		default:
+			return msgs
+		}
+	}
+}
+
+func drain(ch chan []byte) []string {
+	var msgs []string
+	for {
+		select {
+		case msg := <-ch:
+			msgs = append(msgs, string(msg))
+		default:
+			return msgs
+		}
+	}
+}
+
+func TestCommandRepliesGoToTheConnection(t *testing.T) {
+	c := &connection{send: make(chan []byte, 10)}
+	drainEvents(h.broadcastSys)
+
+	reply := func() connEvent {
+		m := <-h.reply
+		require.Equal(t, c, m.conn)
+		return m
+	}
+
+	go spQueue(c, "queue /dev/ttyNONE")
+	m := reply()
+	require.Equal(t, eventError, m.event.Type)
+	require.Contains(t, string(m.event.encode(false)), "/dev/ttyNONE")
+
+	go serialPorts.ListTo(c)
+	require.Equal(t, eventPortList, reply().event.Type)
+
+	go spAlias(c, "aliases")
+	require.Equal(t, eventAliases, reply().event.Type)
+
+	// nothing was broadcast
+	require.Empty(t, drainEvents(h.broadcastSys))
+}
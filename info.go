@@ -44,7 +44,7 @@ func pauseHandler(c *gin.Context) {
 	go func() {
 		ports, _ := serial.GetPortsList()
 		for _, element := range ports {
-			spClose(element)
+			closePort(element)
 		}
 		*hibernate = true
 		Systray.Pause()
@@ -20,10 +20,10 @@ package main
 
 import (
 	_ "embed"
-	"encoding/json"
 	"flag"
 	"html/template"
 	"io"
+	"net/http"
 	"os"
 	"regexp"
 	"runtime"
@@ -87,6 +87,9 @@ var (
 	crashreport       = iniConf.Bool("crashreport", false, "enable crashreport logging")
 	autostartMacOS    = iniConf.Bool("autostartMacOS", true, "the Arduino Create Agent is able to start automatically after login on macOS (launchd agent)")
 	installCerts      = iniConf.Bool("installCerts", false, "install the HTTPS certificate for Safari and keep it updated")
+	allowPorts        = iniConf.String("allowPorts", "", "Comma separated list of the devices whose ports are listed, by VID (0x2341), VID:PID (0x2341:0x0043) or serial number (serial:ABC123). Empty to list all the devices")
+	denyPorts         = iniConf.String("denyPorts", "", "Comma separated list of the devices whose ports are hidden, in the same format of allowPorts. It wins over allowPorts")
+	virtualPorts      = iniConf.String("virtualPorts", "", "Comma separated list of virtual serial ports to create, each one emulating a device (echo). Linux only")
 )
 
 // the ports filter provided by the user via the -regex flag, if any
@@ -110,7 +113,7 @@ var (
 type logWriter struct{}
 
 func (u *logWriter) Write(p []byte) (n int, err error) {
-	h.broadcastSys <- p
+	broadcastEvent(eventLog, string(p))
 	return len(p), nil
 }
 
@@ -183,9 +186,7 @@ func loop() {
 	}
 
 	logger := func(msg string) {
-		mapD := map[string]string{"DownloadStatus": "Pending", "Msg": msg}
-		mapB, _ := json.Marshal(mapD)
-		h.broadcastSys <- mapB
+		broadcastEvent(eventDownload, map[string]string{"DownloadStatus": "Pending", "Msg": msg})
 	}
 
 	// Let's handle the config
@@ -352,6 +353,13 @@ func loop() {
 		}
 	}
 
+	// see if they provided a devices filter
+	if filter, err := newPortFilter(*allowPorts, *denyPorts); err != nil {
+		log.Panicf("Error parsing the ports filter: %v\n", err)
+	} else {
+		portsDeviceFilter = filter
+	}
+
 	if !*verbose {
 		log.Println("You can enter verbose mode to see all logging by setting the v key in the configuration file to true.")
 		log.SetOutput(io.Discard)
@@ -414,6 +422,18 @@ func loop() {
 		}
 	}
 
+	// load the stable names given to the devices
+	if err := portAliases.Load(config.GetDataDir().Join("port_aliases.json")); err != nil {
+		log.Errorf("Error loading the port aliases: %s", err)
+	}
+
+	// create the virtual ports used for development and testing
+	if *virtualPorts != "" {
+		if err := createVirtualPorts(*virtualPorts); err != nil {
+			log.Errorf("Error creating virtual ports: %s", err)
+		}
+	}
+
 	// launch the discoveries for the running system
 	go serialPorts.Run()
 	// launch the hub routine which is the singleton for the websocket server
@@ -471,9 +491,12 @@ func loop() {
 	r.POST("/pause", pauseHandler)
 	r.POST("/update", updateHandler)
 
-	// Mount goa handlers
-	goa := v2.Server(config.GetDataDir().String(), Index, signaturePubKey)
-	r.Any("/v2/*path", gin.WrapH(goa))
+	// Mount goa handlers, the serial streams are websockets and are served outside of goa
+	goa := v2.Server(config.GetDataDir().String(), Index, signaturePubKey, &serialService{})
+	v2Mux := http.NewServeMux()
+	v2Mux.Handle("/v2/", goa)
+	v2Mux.HandleFunc("GET /v2/serial/{port}/stream", serialStreamHandler)
+	r.Any("/v2/*path", gin.WrapH(v2Mux))
 
 	go func() {
 		// check if certificates exist; if not, use plain http
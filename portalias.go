@@ -0,0 +1,164 @@
+// Copyright 2026 Arduino SA
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+
+	paths "github.com/arduino/go-paths-helper"
+)
+
+// portAlias is a stable name for a device, that keeps working when the
+// device gets a different port after being replugged. The device is
+// identified by its serial number and VID/PID, or only by VID/PID if it
+// has no serial number.
+type portAlias struct {
+	Alias        string `json:"alias"`
+	SerialNumber string `json:"serial_number,omitempty"`
+	VendorID     string `json:"vendor_id"`
+	ProductID    string `json:"product_id"`
+}
+
+func (a *portAlias) matches(port *SpPortItem) bool {
+	if a.SerialNumber != "" && a.SerialNumber != port.SerialNumber {
+		return false
+	}
+	return a.VendorID == port.VendorID && a.ProductID == port.ProductID
+}
+
+// portAliasList is the alias table, saved as json in the data dir
+type portAliasList struct {
+	aliases []portAlias
+	file    *paths.Path
+	mu      sync.Mutex
+}
+
+var portAliases portAliasList
+
+// Load reads the alias table from file, which is also where changes are saved
+func (pl *portAliasList) Load(file *paths.Path) error {
+	pl.mu.Lock()
+	defer pl.mu.Unlock()
+	pl.file = file
+	if file.NotExist() {
+		return nil
+	}
+	data, err := file.ReadFile()
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, &pl.aliases)
+}
+
+// save writes the alias table, the caller must hold the lock
+func (pl *portAliasList) save() error {
+	if pl.file == nil {
+		return nil
+	}
+	data, err := json.MarshalIndent(pl.aliases, "", "  ")
+	if err != nil {
+		return err
+	}
+	return pl.file.WriteFile(data)
+}
+
+// Set gives the alias to the device connected to port, replacing its previous alias if any
+func (pl *portAliasList) Set(alias string, port *SpPortItem) (*portAlias, error) {
+	if alias == "" || strings.ContainsAny(alias, " \t\n/\\") {
+		return nil, errors.New("invalid alias " + alias + ", it can't be empty or contain spaces and slashes")
+	}
+	if port.VendorID == "" {
+		return nil, errors.New("the device connected to " + port.Name + " has no VID/PID to be identified by")
+	}
+	pl.mu.Lock()
+	defer pl.mu.Unlock()
+
+	newAlias := portAlias{
+		Alias:        alias,
+		SerialNumber: port.SerialNumber,
+		VendorID:     port.VendorID,
+		ProductID:    port.ProductID,
+	}
+	aliases := []portAlias{newAlias}
+	for _, a := range pl.aliases {
+		sameDevice := a.SerialNumber == newAlias.SerialNumber && a.VendorID == newAlias.VendorID && a.ProductID == newAlias.ProductID
+		if a.Alias != alias && !sameDevice {
+			aliases = append(aliases, a)
+		}
+	}
+	pl.aliases = aliases
+	return &newAlias, pl.save()
+}
+
+// Remove deletes the alias, it returns false if there was no such alias
+func (pl *portAliasList) Remove(alias string) (bool, error) {
+	pl.mu.Lock()
+	defer pl.mu.Unlock()
+	for i, a := range pl.aliases {
+		if a.Alias == alias {
+			pl.aliases = append(pl.aliases[:i], pl.aliases[i+1:]...)
+			return true, pl.save()
+		}
+	}
+	return false, nil
+}
+
+// List returns a copy of the alias table
+func (pl *portAliasList) List() []portAlias {
+	pl.mu.Lock()
+	defer pl.mu.Unlock()
+	return append([]portAlias{}, pl.aliases...)
+}
+
+// AliasOf returns the alias of the device connected to the port, if any
+func (pl *portAliasList) AliasOf(port *SpPortItem) string {
+	pl.mu.Lock()
+	defer pl.mu.Unlock()
+	for _, a := range pl.aliases {
+		if a.matches(port) {
+			return a.Alias
+		}
+	}
+	return ""
+}
+
+// Resolve returns the port the aliased device is connected to.
+// Names that are not aliases, or whose device is not connected, are returned unchanged.
+func (pl *portAliasList) Resolve(name string) string {
+	var alias *portAlias
+	pl.mu.Lock()
+	for _, a := range pl.aliases {
+		if a.Alias == name {
+			alias = &a
+			break
+		}
+	}
+	pl.mu.Unlock()
+	if alias == nil {
+		return name
+	}
+
+	// the port list takes the alias lock, so it can't be held here
+	for _, port := range serialPorts.Items() {
+		if alias.matches(&port) {
+			return port.Name
+		}
+	}
+	return name
+}
@@ -0,0 +1,111 @@
+// Copyright 2026 Arduino SA
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"encoding/json"
+	"testing"
+
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPortAliases(t *testing.T) {
+	file := paths.New(t.TempDir(), "port_aliases.json")
+	var aliases portAliasList
+	require.NoError(t, aliases.Load(file))
+
+	uno := &SpPortItem{Name: "/dev/ttyACM0", SerialNumber: "12345", VendorID: "0x2341", ProductID: "0x0043"}
+	clone := &SpPortItem{Name: "/dev/ttyUSB0", VendorID: "0x1a86", ProductID: "0x7523"}
+	_, err := aliases.Set("printer", uno)
+	require.NoError(t, err)
+	_, err = aliases.Set("plotter", clone)
+	require.NoError(t, err)
+	require.Equal(t, "printer", aliases.AliasOf(uno))
+	require.Equal(t, "plotter", aliases.AliasOf(&SpPortItem{Name: "/dev/ttyUSB3", VendorID: "0x1a86", ProductID: "0x7523"}))
+	require.Empty(t, aliases.AliasOf(&SpPortItem{Name: "/dev/ttyACM1", SerialNumber: "67890", VendorID: "0x2341", ProductID: "0x0043"}))
+
+	// a device has a single alias
+	_, err = aliases.Set("laser", uno)
+	require.NoError(t, err)
+	require.Equal(t, "laser", aliases.AliasOf(uno))
+
+	_, err = aliases.Set("my printer", uno)
+	require.Error(t, err)
+	_, err = aliases.Set("printer", &SpPortItem{Name: "/dev/ttyS0"})
+	require.Error(t, err)
+
+	// the table survives restarts
+	var reloaded portAliasList
+	require.NoError(t, reloaded.Load(file))
+	require.ElementsMatch(t, aliases.List(), reloaded.List())
+	require.Len(t, reloaded.List(), 2)
+
+	found, err := aliases.Remove("plotter")
+	require.NoError(t, err)
+	require.True(t, found)
+	found, err = aliases.Remove("plotter")
+	require.NoError(t, err)
+	require.False(t, found)
+	require.Empty(t, aliases.AliasOf(clone))
+}
+
+func TestPortAliasesResolve(t *testing.T) {
+	serialPorts.portsLock.Lock()
+	serialPorts.Ports = []*SpPortItem{{Name: "/dev/ttyACM3", SerialNumber: "12345", VendorID: "0x2341", ProductID: "0x0043"}}
+	serialPorts.portsLock.Unlock()
+	defer func() {
+		serialPorts.portsLock.Lock()
+		serialPorts.Ports = nil
+		serialPorts.portsLock.Unlock()
+		portAliases.Remove("printer")
+	}()
+
+	_, err := portAliases.Set("printer", &SpPortItem{Name: "/dev/ttyACM0", SerialNumber: "12345", VendorID: "0x2341", ProductID: "0x0043"})
+	require.NoError(t, err)
+	_, err = portAliases.Set("plotter", &SpPortItem{Name: "/dev/ttyUSB0", VendorID: "0x1a86", ProductID: "0x7523"})
+	require.NoError(t, err)
+	defer portAliases.Remove("plotter")
+
+	// the device has been replugged on another port
+	require.Equal(t, "/dev/ttyACM3", portAliases.Resolve("printer"))
+	require.Equal(t, "/dev/ttyACM3", portAliases.Resolve("/dev/ttyACM3"))
+	// the device is not connected
+	require.Equal(t, "plotter", portAliases.Resolve("plotter"))
+
+	item, ok := serialPorts.Item("printer")
+	require.True(t, ok)
+	require.Equal(t, "printer", item.Alias)
+	require.Equal(t, "/dev/ttyACM3", item.Name)
+
+	p := &serport{portName: "/dev/ttyACM3", portConf: newSerialConfig("/dev/ttyACM3", 9600), sendBuffered: make(chan serialWrite), sendNoBuf: make(chan serialWrite), done: make(chan struct{})}
+	sh.Register(p)
+	defer sh.Unregister(p)
+	found, ok := sh.FindPortByName("printer")
+	require.True(t, ok)
+	require.Equal(t, p, found)
+
+	// the subscriptions are kept by port name
+	hub := &hub{connections: make(map[*connection]bool)}
+	c := &connection{send: make(chan []byte, 10)}
+	hub.connections[c] = true
+	hub.subscribe(c, "subscribe printer")
+	require.True(t, c.isSubscribedTo("/dev/ttyACM3"))
+	require.Equal(t, []string{"/dev/ttyACM3"}, c.subscribedPorts())
+	_, err = rpcSubscribe(false)(c, json.RawMessage(`{"port":"printer"}`))
+	require.NoError(t, err)
+	require.False(t, c.isSubscribedTo("/dev/ttyACM3"))
+}
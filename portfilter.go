@@ -0,0 +1,125 @@
+// Copyright 2026 Arduino SA
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// portFilterRule matches the devices with a VID, a VID:PID or a serial number
+type portFilterRule struct {
+	vendorID     string
+	productID    string
+	serialNumber string
+}
+
+// portFilter hides the ports of the devices that are denied, or that
+// are not allowed when an allowlist is given
+type portFilter struct {
+	allow []portFilterRule
+	deny  []portFilterRule
+}
+
+// the ports filter provided by the user via the allowPorts and denyPorts keys, if any
+var portsDeviceFilter portFilter
+
+// normalizeUSBID turns a USB id into the form reported by the discovery, i.e. 0x2341
+func normalizeUSBID(usbID string) (string, error) {
+	id := strings.ToLower(usbID)
+	id = strings.TrimPrefix(id, "0x")
+	if len(id) == 0 || len(id) > 4 {
+		return "", fmt.Errorf("invalid USB id %q", usbID)
+	}
+	for _, c := range id {
+		if !strings.ContainsRune("0123456789abcdef", c) {
+			return "", fmt.Errorf("invalid USB id %q", usbID)
+		}
+	}
+	return "0x" + strings.Repeat("0", 4-len(id)) + id, nil
+}
+
+// parsePortFilterRules parses a comma separated list of rules, each one being
+// a VID (0x2341), a VID:PID (0x2341:0x0043) or a serial number (serial:ABC123)
+func parsePortFilterRules(rules string) ([]portFilterRule, error) {
+	var res []portFilterRule
+	for _, rule := range strings.Split(rules, ",") {
+		rule = strings.TrimSpace(rule)
+		if rule == "" {
+			continue
+		}
+		if sn, ok := strings.CutPrefix(rule, "serial:"); ok {
+			if sn == "" {
+				return nil, fmt.Errorf("missing serial number in %q", rule)
+			}
+			res = append(res, portFilterRule{serialNumber: sn})
+			continue
+		}
+		vid, pid, hasPid := strings.Cut(rule, ":")
+		var r portFilterRule
+		var err error
+		if r.vendorID, err = normalizeUSBID(vid); err != nil {
+			return nil, err
+		}
+		if hasPid {
+			if r.productID, err = normalizeUSBID(pid); err != nil {
+				return nil, err
+			}
+		}
+		res = append(res, r)
+	}
+	return res, nil
+}
+
+func newPortFilter(allow, deny string) (portFilter, error) {
+	var f portFilter
+	var err error
+	if f.allow, err = parsePortFilterRules(allow); err != nil {
+		return f, fmt.Errorf("allowPorts: %w", err)
+	}
+	if f.deny, err = parsePortFilterRules(deny); err != nil {
+		return f, fmt.Errorf("denyPorts: %w", err)
+	}
+	return f, nil
+}
+
+func (r *portFilterRule) matches(vid, pid, serialNumber string) bool {
+	if r.serialNumber != "" {
+		return r.serialNumber == serialNumber
+	}
+	if r.vendorID != strings.ToLower(vid) {
+		return false
+	}
+	return r.productID == "" || r.productID == strings.ToLower(pid)
+}
+
+// Allows tells if the device can be listed and opened. The denylist wins over the allowlist.
+func (f *portFilter) Allows(vid, pid, serialNumber string) bool {
+	for i := range f.deny {
+		if f.deny[i].matches(vid, pid, serialNumber) {
+			return false
+		}
+	}
+	if len(f.allow) == 0 {
+		return true
+	}
+	for i := range f.allow {
+		if f.allow[i].matches(vid, pid, serialNumber) {
+			return true
+		}
+	}
+	return false
+}
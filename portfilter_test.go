@@ -0,0 +1,109 @@
+// Copyright 2026 Arduino SA
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"testing"
+
+	properties "github.com/arduino/go-properties-orderedmap"
+	discovery "github.com/arduino/pluggable-discovery-protocol-handler/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPortFilter(t *testing.T) {
+	f, err := newPortFilter("0x2341, 2a03:43", "0x2341:0x0058, serial:LAB42")
+	require.NoError(t, err)
+	require.True(t, f.Allows("0x2341", "0x0043", "12345"))
+	require.True(t, f.Allows("0x2A03", "0x0043", ""))
+	require.False(t, f.Allows("0x2a03", "0x0042", ""))
+	require.False(t, f.Allows("0x1a86", "0x7523", ""))
+	// the denylist wins
+	require.False(t, f.Allows("0x2341", "0x0058", ""))
+	require.False(t, f.Allows("0x2341", "0x0043", "LAB42"))
+
+	f, err = newPortFilter("", "0x1a86")
+	require.NoError(t, err)
+	require.True(t, f.Allows("0x2341", "0x0043", ""))
+	require.False(t, f.Allows("0x1a86", "0x7523", ""))
+
+	_, err = newPortFilter("0x2341:", "")
+	require.ErrorContains(t, err, "allowPorts")
+	_, err = newPortFilter("", "serial:")
+	require.ErrorContains(t, err, "denyPorts")
+	_, err = newPortFilter("0xzz41", "")
+	require.Error(t, err)
+}
+
+func TestPortFilterHidesPorts(t *testing.T) {
+	var err error
+	portsDeviceFilter, err = newPortFilter("", "0x1a86:0x7523")
+	require.NoError(t, err)
+	defer func() { portsDeviceFilter = portFilter{} }()
+
+	var sp SerialPortList
+	port := func(address, vid, pid string) *discovery.Port {
+		return &discovery.Port{
+			Address:    address,
+			Protocol:   "serial",
+			Properties: properties.NewFromHashmap(map[string]string{"vid": vid, "pid": pid}),
+		}
+	}
+	sp.add(port("/dev/ttyACM0", "0x2341", "0x0043"))
+	sp.add(port("/dev/ttyUSB0", "0x1a86", "0x7523"))
+	require.Len(t, sp.Ports, 1)
+	require.Equal(t, "/dev/ttyACM0", sp.Ports[0].Name)
+	require.True(t, sp.IsFiltered("/dev/ttyUSB0"))
+	require.False(t, sp.IsFiltered("/dev/ttyACM0"))
+
+	// a filtered device plugged on a listed port hides it
+	sp.add(port("/dev/ttyACM0", "0x1a86", "0x7523"))
+	require.Empty(t, sp.Ports)
+	require.True(t, sp.IsFiltered("/dev/ttyACM0"))
+
+	sp.remove(port("/dev/ttyUSB0", "0x1a86", "0x7523"))
+	require.False(t, sp.IsFiltered("/dev/ttyUSB0"))
+}
+
+func TestPortFilterAllowlistRequiresListedPorts(t *testing.T) {
+	var err error
+	portsDeviceFilter, err = newPortFilter("0x2341", "")
+	require.NoError(t, err)
+	defer func() { portsDeviceFilter = portFilter{} }()
+
+	var sp SerialPortList
+	sp.add(&discovery.Port{
+		Address:    "/dev/ttyACM0",
+		Protocol:   "serial",
+		Properties: properties.NewFromHashmap(map[string]string{"vid": "0x2341", "pid": "0x0043"}),
+	})
+	// skipped by the discovery before the filter, i.e. with a zero VID
+	sp.add(&discovery.Port{
+		Address:    "/dev/ttyS0",
+		Protocol:   "serial",
+		Properties: properties.NewFromHashmap(map[string]string{"vid": "0x0000", "pid": "0x0000"}),
+	})
+	sp.addVirtual("/dev/ttyVIRTUAL", "echo")
+
+	require.False(t, sp.IsFiltered("/dev/ttyACM0"))
+	require.False(t, sp.IsFiltered("/dev/ttyVIRTUAL"))
+	require.True(t, sp.IsFiltered("/dev/ttyS0"))
+	require.True(t, sp.IsFiltered("/dev/ttyUSB3"))
+	require.True(t, sp.IsFiltered("/dev/serial/by-id/usb-1a86_USB_Serial-if00-port0"))
+
+	// without an allowlist the ports not listed can be opened
+	portsDeviceFilter = portFilter{}
+	require.False(t, sp.IsFiltered("/dev/ttyUSB3"))
+}
@@ -0,0 +1,36 @@
+// Copyright 2026 Arduino SA
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"strconv"
+	"strings"
+)
+
+// portHolder is a process that keeps a serial port open
+type portHolder struct {
+	PID  int
+	Name string
+}
+
+// describePortHolders returns a human readable list of the processes, i.e. "java (PID 1234)"
+func describePortHolders(holders []portHolder) string {
+	descs := make([]string, len(holders))
+	for i, holder := range holders {
+		descs[i] = holder.Name + " (PID " + strconv.Itoa(holder.PID) + ")"
+	}
+	return strings.Join(descs, ", ")
+}
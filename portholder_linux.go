@@ -0,0 +1,59 @@
+// Copyright 2026 Arduino SA
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// findPortHolders looks for the processes having the device open, by scanning
+// their file descriptors in /proc. The processes of other users can't be
+// inspected unless the agent is running as root.
+func findPortHolders(portname string) []portHolder {
+	// the port can be a symlink, i.e. /dev/serial/by-id/...
+	device, err := filepath.EvalSymlinks(portname)
+	if err != nil {
+		return nil
+	}
+	procs, err := os.ReadDir("/proc")
+	if err != nil {
+		return nil
+	}
+
+	var holders []portHolder
+	for _, proc := range procs {
+		pid, err := strconv.Atoi(proc.Name())
+		if err != nil {
+			continue
+		}
+		fdDir := filepath.Join("/proc", proc.Name(), "fd")
+		fds, err := os.ReadDir(fdDir)
+		if err != nil {
+			continue
+		}
+		for _, fd := range fds {
+			if target, err := os.Readlink(filepath.Join(fdDir, fd.Name())); err == nil && target == device {
+				comm, _ := os.ReadFile(filepath.Join("/proc", proc.Name(), "comm"))
+				holders = append(holders, portHolder{PID: pid, Name: strings.TrimSpace(string(comm))})
+				break
+			}
+		}
+	}
+	return holders
+}
@@ -0,0 +1,50 @@
+// Copyright 2026 Arduino SA
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindPortHolders(t *testing.T) {
+	dir := t.TempDir()
+	device := filepath.Join(dir, "ttyHELD")
+	f, err := os.Create(device)
+	require.NoError(t, err)
+	defer f.Close()
+	// ports are often opened through a symlink
+	link := filepath.Join(dir, "by-id")
+	require.NoError(t, os.Symlink(device, link))
+
+	comm, err := os.ReadFile("/proc/self/comm")
+	require.NoError(t, err)
+	holder := portHolder{PID: os.Getpid(), Name: strings.TrimSpace(string(comm))}
+	require.Contains(t, findPortHolders(device), holder)
+	require.Contains(t, findPortHolders(link), holder)
+
+	require.NoError(t, f.Close())
+	require.Empty(t, findPortHolders(device))
+	require.Empty(t, findPortHolders(filepath.Join(dir, "missing")))
+}
+
+func TestDescribePortHolders(t *testing.T) {
+	require.Equal(t, "java (PID 1234), ModemManager (PID 42)", describePortHolders([]portHolder{{1234, "java"}, {42, "ModemManager"}}))
+}
@@ -0,0 +1,133 @@
+// Copyright 2026 Arduino SA
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"slices"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// reconnectAttempts is how many times a port that came back is opened before giving up
+	reconnectAttempts = 5
+	// reconnectDelay is the time given to the OS to set up a port that came back
+	reconnectDelay = 500 * time.Millisecond
+)
+
+// reconnectRequest describes a port, opened with autoreconnect, that went away
+type reconnectRequest struct {
+	conf         *SerialConfig
+	bufferType   string
+	serialNumber string
+	vendorID     string
+	productID    string
+}
+
+// matches returns true if the port just added to the list is the device that went away.
+// Devices are identified by serial number and VID/PID, falling back to the port name.
+func (r *reconnectRequest) matches(name, serialNumber, vid, pid string) bool {
+	if r.serialNumber != "" {
+		return r.serialNumber == serialNumber && r.vendorID == vid && r.productID == pid
+	}
+	if r.vendorID != "" {
+		return r.vendorID == vid && r.productID == pid
+	}
+	return r.conf.Name == name
+}
+
+// portReconnector keeps track of the ports waiting to be reopened
+type portReconnector struct {
+	pending []*reconnectRequest
+	mu      sync.Mutex
+}
+
+var reconnector portReconnector
+
+// Wait remembers the port so that it is reopened when the device comes back
+func (r *portReconnector) Wait(p *serport) {
+	r.mu.Lock()
+	r.pending = append(r.pending, &reconnectRequest{
+		conf:         p.portConf,
+		bufferType:   p.BufferType,
+		serialNumber: p.serialNumber,
+		vendorID:     p.vendorID,
+		productID:    p.productID,
+	})
+	r.mu.Unlock()
+
+	log.Println("Waiting for " + p.portName + " to come back")
+	broadcastEvent(eventReconnect, map[string]string{
+		"Cmd":  "ReconnectWait",
+		"Desc": "Waiting for the device to come back.",
+		"Port": p.portName,
+	})
+}
+
+// Cancel stops waiting for the port with the given name, returns false if it was not waited for
+func (r *portReconnector) Cancel(portname string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	n := len(r.pending)
+	r.pending = slices.DeleteFunc(r.pending, func(req *reconnectRequest) bool {
+		return req.conf.Name == portname
+	})
+	return len(r.pending) != n
+}
+
+// PortAdded is called by the port list when a new port shows up
+func (r *portReconnector) PortAdded(name, serialNumber, vid, pid string) {
+	r.mu.Lock()
+	idx := slices.IndexFunc(r.pending, func(req *reconnectRequest) bool {
+		return req.matches(name, serialNumber, vid, pid)
+	})
+	if idx == -1 {
+		r.mu.Unlock()
+		return
+	}
+	req := r.pending[idx]
+	r.pending = slices.Delete(r.pending, idx, idx+1)
+	r.mu.Unlock()
+
+	conf := *req.conf
+	conf.Name = name
+	var err error
+	for attempt := 0; attempt < reconnectAttempts; attempt++ {
+		time.Sleep(reconnectDelay)
+		if err = spHandlerOpen(&conf, req.bufferType); err == nil {
+			break
+		}
+	}
+	if err != nil {
+		log.Println("Could not reconnect " + name + ": " + err.Error())
+		// keep waiting, the device may come back again
+		r.mu.Lock()
+		r.pending = append(r.pending, req)
+		r.mu.Unlock()
+		return
+	}
+
+	broadcastEvent(eventReconnect, map[string]interface{}{
+		"Cmd":          "Reconnected",
+		"Desc":         "Port reopened after the device came back.",
+		"Port":         name,
+		"PreviousPort": req.conf.Name,
+		"Baud":         conf.Baud,
+		"BufferType":   req.bufferType,
+	})
+}
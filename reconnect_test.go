@@ -0,0 +1,107 @@
+// Copyright 2026 Arduino SA
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	properties "github.com/arduino/go-properties-orderedmap"
+	discovery "github.com/arduino/pluggable-discovery-protocol-handler/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReconnectRequestMatches(t *testing.T) {
+	bySerial := &reconnectRequest{conf: newSerialConfig("/dev/ttyACM0", 9600), serialNumber: "ABC", vendorID: "0x2341", productID: "0x0043"}
+	require.True(t, bySerial.matches("/dev/ttyACM1", "ABC", "0x2341", "0x0043"))
+	require.False(t, bySerial.matches("/dev/ttyACM0", "DEF", "0x2341", "0x0043"))
+
+	byVidPid := &reconnectRequest{conf: newSerialConfig("/dev/ttyACM0", 9600), vendorID: "0x2341", productID: "0x0043"}
+	require.True(t, byVidPid.matches("/dev/ttyACM1", "", "0x2341", "0x0043"))
+	require.False(t, byVidPid.matches("/dev/ttyACM0", "", "0x2341", "0x0001"))
+
+	byName := &reconnectRequest{conf: newSerialConfig("/dev/ttyACM0", 9600)}
+	require.True(t, byName.matches("/dev/ttyACM0", "ABC", "0x2341", "0x0043"))
+	require.False(t, byName.matches("/dev/ttyACM1", "ABC", "0x2341", "0x0043"))
+}
+
+func TestReconnectReopensThePort(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	defer func() {
+		serialPorts.portsLock.Lock()
+		serialPorts.Ports = nil
+		serialPorts.portsLock.Unlock()
+	}()
+
+	// an empty recording behaves like a quiet device
+	recorder, err := newSerialRecorder("/dev/ttyACM0")
+	require.NoError(t, err)
+	require.NoError(t, recorder.Close())
+	name := replayPortPrefix + filepath.Base(recorder.Name())
+	device := &discovery.Port{
+		Address:    name,
+		Protocol:   "serial",
+		Properties: properties.NewFromHashmap(map[string]string{"vid": "0x2341", "pid": "0x0043"}),
+	}
+
+	isOpen := func() bool {
+		_, ok := sh.FindPortByName(name)
+		return ok
+	}
+	isWaiting := func() bool {
+		reconnector.mu.Lock()
+		defer reconnector.mu.Unlock()
+		return len(reconnector.pending) > 0
+	}
+	// unplug fails the port as a disconnected device does, and removes it from the list
+	unplug := func() {
+		port, ok := sh.FindPortByName(name)
+		require.True(t, ok)
+		port.portIo.Close()
+		require.Eventually(t, isWaiting, time.Second, 10*time.Millisecond)
+		require.False(t, isOpen())
+		serialPorts.remove(device)
+	}
+
+	conf := newSerialConfig(name, 57600)
+	for _, option := range []string{"parity=even", "autoreconnect=on", "terminator=crlf"} {
+		require.NoError(t, conf.SetOption(option))
+	}
+	require.NoError(t, spHandlerOpen(conf, "lines"))
+	unplug()
+
+	// the device comes back and it is opened as before
+	serialPorts.add(device)
+	require.Eventually(t, isOpen, 5*time.Second, 10*time.Millisecond)
+	require.False(t, isWaiting())
+	port, _ := sh.FindPortByName(name)
+	reopened := port.Config()
+	require.Equal(t, 57600, reopened.Baud)
+	require.Equal(t, "even", reopened.Parity)
+	require.True(t, reopened.AutoReconnect)
+	require.Equal(t, map[string]string{"terminator": "crlf"}, reopened.BufferParams)
+	require.Equal(t, "lines", port.BufferType)
+
+	// close stops waiting for the device
+	unplug()
+	require.True(t, closePort(name))
+	require.False(t, isWaiting())
+	serialPorts.add(device)
+	time.Sleep(2 * reconnectDelay)
+	require.False(t, isOpen())
+	require.False(t, closePort(name))
+}
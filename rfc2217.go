@@ -0,0 +1,554 @@
+// Copyright 2026 Arduino SA
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"encoding/binary"
+	"errors"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Telnet commands and options, see RFC 854, RFC 856 and RFC 858
+const (
+	telnetSE   = 240
+	telnetSB   = 250
+	telnetWILL = 251
+	telnetWONT = 252
+	telnetDO   = 253
+	telnetDONT = 254
+	telnetIAC  = 255
+
+	telnetBinary  = 0
+	telnetSGA     = 3
+	telnetComPort = 44
+)
+
+// RFC 2217 commands sent by the client, the server replies adding 100
+const (
+	rfc2217Signature         = 0
+	rfc2217SetBaudrate       = 1
+	rfc2217SetDatasize       = 2
+	rfc2217SetParity         = 3
+	rfc2217SetStopsize       = 4
+	rfc2217SetControl        = 5
+	rfc2217NotifyLinestate   = 6
+	rfc2217NotifyModemstate  = 7
+	rfc2217SetLinestateMask  = 10
+	rfc2217SetModemstateMask = 11
+	rfc2217PurgeData         = 12
+
+	rfc2217ServerOffset = 100
+)
+
+// RFC 2217 values of the SET-CONTROL command
+const (
+	rfc2217ControlFlowRequest  = 0
+	rfc2217ControlFlowNone     = 1
+	rfc2217ControlFlowXonXoff  = 2
+	rfc2217ControlFlowHardware = 3
+	rfc2217ControlBreakRequest = 4
+	rfc2217ControlBreakOn      = 5
+	rfc2217ControlBreakOff     = 6
+	rfc2217ControlDTRRequest   = 7
+	rfc2217ControlDTROn        = 8
+	rfc2217ControlDTROff       = 9
+	rfc2217ControlRTSRequest   = 10
+	rfc2217ControlRTSOn        = 11
+	rfc2217ControlRTSOff       = 12
+)
+
+var rfc2217Parities = map[byte]string{1: "none", 2: "odd", 3: "even", 4: "mark", 5: "space"}
+
+var rfc2217StopBits = map[byte]string{1: "1", 2: "2", 3: "1.5"}
+
+// rfc2217Server shares an open serial port over TCP using the
+// Telnet COM port control protocol (RFC 2217)
+type rfc2217Server struct {
+	portname string
+	listener net.Listener
+
+	conns map[net.Conn]bool
+	mu    sync.Mutex
+}
+
+// rfc2217ServerList keeps track of the RFC 2217 servers of the ports
+type rfc2217ServerList struct {
+	servers map[string]*rfc2217Server
+	mu      sync.Mutex
+}
+
+var rfc2217Servers = rfc2217ServerList{
+	servers: make(map[string]*rfc2217Server),
+}
+
+// Start listens for RFC 2217 clients of the port on the given address
+func (sl *rfc2217ServerList) Start(portname string, address string) (*rfc2217Server, error) {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+	if _, ok := sl.servers[portname]; ok {
+		return nil, errors.New("an RFC 2217 server is already running for " + portname)
+	}
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, err
+	}
+	s := &rfc2217Server{
+		portname: portname,
+		listener: listener,
+		conns:    make(map[net.Conn]bool),
+	}
+	sl.servers[portname] = s
+	go s.serve()
+	return s, nil
+}
+
+// Stop closes the RFC 2217 server of the port and its clients.
+// It returns false if there was no server running.
+func (sl *rfc2217ServerList) Stop(portname string) bool {
+	sl.mu.Lock()
+	s, ok := sl.servers[portname]
+	delete(sl.servers, portname)
+	sl.mu.Unlock()
+	if !ok {
+		return false
+	}
+	s.listener.Close()
+	s.mu.Lock()
+	for conn := range s.conns {
+		conn.Close()
+	}
+	s.mu.Unlock()
+	return true
+}
+
+// Addr returns the address the server is listening on
+func (s *rfc2217Server) Addr() string {
+	return s.listener.Addr().String()
+}
+
+func (s *rfc2217Server) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		log.Println("RFC 2217 client " + conn.RemoteAddr().String() + " connected to " + s.portname)
+		s.mu.Lock()
+		s.conns[conn] = true
+		s.mu.Unlock()
+		go func() {
+			newRfc2217Conn(s.portname, conn).serve()
+			s.mu.Lock()
+			delete(s.conns, conn)
+			s.mu.Unlock()
+			log.Println("RFC 2217 client " + conn.RemoteAddr().String() + " disconnected from " + s.portname)
+		}()
+	}
+}
+
+// rfc2217Conn is a client of an RFC 2217 server
+type rfc2217Conn struct {
+	portname string
+	conn     net.Conn
+	// both the reader and the stream writer send to the client
+	writeLock sync.Mutex
+
+	// options enabled by us and by the client
+	we, they map[byte]bool
+
+	// telnet parser state
+	state      int
+	command    byte
+	subneg     []byte
+	breakStart time.Time
+}
+
+// states of the telnet parser
+const (
+	telnetStateData = iota
+	telnetStateIAC
+	telnetStateOption
+	telnetStateSubneg
+	telnetStateSubnegIAC
+)
+
+// maxSubnegLength limits the subnegotiations, the longest RFC 2217 one is the signature
+const maxSubnegLength = 256
+
+func newRfc2217Conn(portname string, conn net.Conn) *rfc2217Conn {
+	return &rfc2217Conn{
+		portname: portname,
+		conn:     conn,
+		we:       make(map[byte]bool),
+		they:     make(map[byte]bool),
+	}
+}
+
+func (c *rfc2217Conn) serve() {
+	defer c.conn.Close()
+	// a failure must only drop the client, not the agent
+	defer func() {
+		if e := recover(); e != nil {
+			log.Println("RFC 2217 client of "+c.portname+" dropped:", e)
+		}
+	}()
+
+	stream := &serialStream{send: make(chan []byte, 256)}
+	serialStreams.add(c.portname, stream)
+	defer serialStreams.Remove(c.portname, stream)
+	go c.writer(stream)
+
+	c.offer(telnetWILL, telnetBinary)
+	c.offer(telnetDO, telnetBinary)
+	c.offer(telnetWILL, telnetSGA)
+	c.offer(telnetWILL, telnetComPort)
+	c.offer(telnetDO, telnetComPort)
+
+	buf := make([]byte, 1024)
+	for {
+		n, err := c.conn.Read(buf)
+		if err != nil {
+			return
+		}
+		data := c.parse(buf[:n])
+		if len(data) == 0 {
+			continue
+		}
+		// the port may have been closed in the meantime
+		port, ok := sh.FindPortByName(c.portname)
+		if !ok {
+			return
+		}
+		if err := port.Write(string(data), "sendnobuf", "", nil); err != nil {
+			return
+		}
+	}
+}
+
+// writer sends the data read from the port to the client
+func (c *rfc2217Conn) writer(stream *serialStream) {
+	defer c.conn.Close()
+	for data := range stream.send {
+		if err := c.send(telnetEscape(data)); err != nil {
+			return
+		}
+	}
+}
+
+func (c *rfc2217Conn) send(data []byte) error {
+	c.writeLock.Lock()
+	defer c.writeLock.Unlock()
+	_, err := c.conn.Write(data)
+	return err
+}
+
+// telnetEscape doubles the IAC bytes of the data
+func telnetEscape(data []byte) []byte {
+	escaped := make([]byte, 0, len(data))
+	for _, b := range data {
+		escaped = append(escaped, b)
+		if b == telnetIAC {
+			escaped = append(escaped, telnetIAC)
+		}
+	}
+	return escaped
+}
+
+// parse handles the telnet commands found in buf and returns the data to write to the port
+func (c *rfc2217Conn) parse(buf []byte) []byte {
+	var data []byte
+	for _, b := range buf {
+		switch c.state {
+		case telnetStateData:
+			if b == telnetIAC {
+				c.state = telnetStateIAC
+			} else {
+				data = append(data, b)
+			}
+		case telnetStateIAC:
+			switch b {
+			case telnetIAC:
+				data = append(data, b)
+				c.state = telnetStateData
+			case telnetWILL, telnetWONT, telnetDO, telnetDONT:
+				c.command = b
+				c.state = telnetStateOption
+			case telnetSB:
+				c.subneg = c.subneg[:0]
+				c.state = telnetStateSubneg
+			default:
+				// NOP, GA and the other commands have no meaning for a serial port
+				c.state = telnetStateData
+			}
+		case telnetStateOption:
+			c.negotiate(c.command, b)
+			c.state = telnetStateData
+		case telnetStateSubneg:
+			if b == telnetIAC {
+				c.state = telnetStateSubnegIAC
+			} else {
+				c.appendSubneg(b)
+			}
+		case telnetStateSubnegIAC:
+			switch b {
+			case telnetIAC:
+				c.state = telnetStateSubneg
+				c.appendSubneg(b)
+			case telnetSE:
+				if len(c.subneg) > 1 && c.subneg[0] == telnetComPort {
+					c.comPortCommand(c.subneg[1], c.subneg[2:])
+				}
+				c.state = telnetStateData
+			default:
+				c.state = telnetStateData
+			}
+		}
+	}
+	return data
+}
+
+// appendSubneg adds b to the subnegotiation, a subnegotiation that never ends is dropped
+func (c *rfc2217Conn) appendSubneg(b byte) {
+	if len(c.subneg) >= maxSubnegLength {
+		log.Println("Dropping a subnegotiation longer than " + strconv.Itoa(maxSubnegLength) + " bytes from the RFC 2217 client of " + c.portname)
+		c.subneg = c.subneg[:0]
+		c.state = telnetStateData
+		return
+	}
+	c.subneg = append(c.subneg, b)
+}
+
+// offer enables an option on our side (WILL) or asks the client to enable it (DO)
+func (c *rfc2217Conn) offer(command byte, option byte) {
+	if command == telnetWILL {
+		c.we[option] = true
+	} else {
+		c.they[option] = true
+	}
+	c.send([]byte{telnetIAC, command, option})
+}
+
+// negotiate answers to the option negotiation of the client, replying only
+// when the state of the option changes to avoid negotiation loops
+func (c *rfc2217Conn) negotiate(command byte, option byte) {
+	supported := option == telnetBinary || option == telnetSGA || option == telnetComPort
+	switch command {
+	case telnetWILL:
+		if !supported {
+			c.send([]byte{telnetIAC, telnetDONT, option})
+		} else if !c.they[option] {
+			c.offer(telnetDO, option)
+		}
+	case telnetDO:
+		if !supported {
+			c.send([]byte{telnetIAC, telnetWONT, option})
+		} else if !c.we[option] {
+			c.offer(telnetWILL, option)
+		}
+	case telnetWONT:
+		if c.they[option] {
+			c.they[option] = false
+			c.send([]byte{telnetIAC, telnetDONT, option})
+		}
+	case telnetDONT:
+		if c.we[option] {
+			c.we[option] = false
+			c.send([]byte{telnetIAC, telnetWONT, option})
+		}
+	}
+}
+
+// comPortCommand applies a COM-PORT-OPTION command to the port and replies
+// with the resulting value
+func (c *rfc2217Conn) comPortCommand(command byte, value []byte) {
+	if command == rfc2217Signature {
+		c.reply(command, []byte("arduino-create-agent "+version))
+		return
+	}
+	port, ok := sh.FindPortByName(c.portname)
+	if !ok {
+		return
+	}
+	conf := port.Config()
+
+	switch command {
+	case rfc2217SetBaudrate:
+		if len(value) != 4 {
+			return
+		}
+		if baud := binary.BigEndian.Uint32(value); baud != 0 {
+			conf.Baud = int(baud)
+			c.setMode(port, &conf)
+		}
+		c.reply(command, binary.BigEndian.AppendUint32(nil, uint32(conf.Baud)))
+	case rfc2217SetDatasize:
+		if len(value) != 1 {
+			return
+		}
+		if value[0] != 0 {
+			conf.DataBits = int(value[0])
+			c.setMode(port, &conf)
+		}
+		c.reply(command, []byte{byte(conf.DataBits)})
+	case rfc2217SetParity:
+		if len(value) != 1 {
+			return
+		}
+		if parity, ok := rfc2217Parities[value[0]]; ok {
+			conf.Parity = parity
+			c.setMode(port, &conf)
+		}
+		for code, parity := range rfc2217Parities {
+			if parity == conf.Parity {
+				c.reply(command, []byte{code})
+			}
+		}
+	case rfc2217SetStopsize:
+		if len(value) != 1 {
+			return
+		}
+		if stopBits, ok := rfc2217StopBits[value[0]]; ok {
+			conf.StopBits = stopBits
+			c.setMode(port, &conf)
+		}
+		for code, stopBits := range rfc2217StopBits {
+			if stopBits == conf.StopBits {
+				c.reply(command, []byte{code})
+			}
+		}
+	case rfc2217SetControl:
+		if len(value) != 1 {
+			return
+		}
+		c.reply(command, []byte{c.control(port, &conf, value[0])})
+	case rfc2217NotifyLinestate:
+		c.reply(command, []byte{0})
+	case rfc2217NotifyModemstate:
+		var state byte
+		if status, err := port.ModemStatus(); err == nil {
+			state = rfc2217ModemState(status)
+		}
+		c.reply(command, []byte{state})
+	case rfc2217SetLinestateMask, rfc2217SetModemstateMask:
+		c.reply(command, value)
+	case rfc2217PurgeData:
+		if len(value) != 1 {
+			return
+		}
+		// 2 purges the transmit buffer, 3 both; the receive buffer is not kept by the agent
+		if value[0] == 2 || value[0] == 3 {
+			port.ClearQueue()
+		}
+		c.reply(command, value)
+	}
+}
+
+// control applies a SET-CONTROL command and returns the value to reply with
+func (c *rfc2217Conn) control(port *serport, conf *SerialConfig, value byte) byte {
+	switch value {
+	case rfc2217ControlBreakOn:
+		c.breakStart = time.Now()
+		return rfc2217ControlBreakOn
+	case rfc2217ControlBreakOff:
+		// the serial driver only sends timed breaks, so the break is sent
+		// when it ends, lasting as long as requested by the client
+		if !c.breakStart.IsZero() {
+			if err := port.Break(min(time.Since(c.breakStart), maxBreakDuration)); err != nil {
+				log.Println("Error sending a break to " + c.portname + ": " + err.Error())
+			}
+			c.breakStart = time.Time{}
+		}
+		return rfc2217ControlBreakOff
+	case rfc2217ControlBreakRequest:
+		if c.breakStart.IsZero() {
+			return rfc2217ControlBreakOff
+		}
+		return rfc2217ControlBreakOn
+	case rfc2217ControlDTROn, rfc2217ControlDTROff:
+		if err := port.SetDTR(value == rfc2217ControlDTROn); err != nil {
+			spErr("Error setting the modem line on " + c.portname + ": " + err.Error())
+		}
+		if err := broadcastModemStatus(port); err != nil {
+			spErr(err.Error())
+		}
+		return value
+	case rfc2217ControlDTRRequest:
+		if conf.DtrOn {
+			return rfc2217ControlDTROn
+		}
+		return rfc2217ControlDTROff
+	case rfc2217ControlRTSOn, rfc2217ControlRTSOff:
+		if err := port.SetRTS(value == rfc2217ControlRTSOn); err != nil {
+			spErr("Error setting the modem line on " + c.portname + ": " + err.Error())
+		}
+		if err := broadcastModemStatus(port); err != nil {
+			spErr(err.Error())
+		}
+		return value
+	case rfc2217ControlRTSRequest:
+		if conf.RtsOn {
+			return rfc2217ControlRTSOn
+		}
+		return rfc2217ControlRTSOff
+	}
+	// flow control can't be changed on an open port, so always reply with the current one
+	switch conf.FlowControl {
+	case "rtscts":
+		return rfc2217ControlFlowHardware
+	case "xonxoff":
+		return rfc2217ControlFlowXonXoff
+	}
+	return rfc2217ControlFlowNone
+}
+
+// setMode changes the line settings of the port, on failure conf is
+// reverted to the current settings so that the client gets them back
+func (c *rfc2217Conn) setMode(port *serport, conf *SerialConfig) {
+	if err := setPortMode(port, conf); err != nil {
+		spErr("Error changing the mode of " + c.portname + ": " + err.Error())
+		*conf = port.Config()
+	}
+}
+
+func (c *rfc2217Conn) reply(command byte, value []byte) {
+	msg := []byte{telnetIAC, telnetSB, telnetComPort, command + rfc2217ServerOffset}
+	msg = append(msg, telnetEscape(value)...)
+	msg = append(msg, telnetIAC, telnetSE)
+	c.send(msg)
+}
+
+// rfc2217ModemState encodes the modem lines as in the NOTIFY-MODEMSTATE command
+func rfc2217ModemState(status *SpModemStatus) byte {
+	var state byte
+	if status.CTS {
+		state |= 0x10
+	}
+	if status.DSR {
+		state |= 0x20
+	}
+	if status.RI {
+		state |= 0x40
+	}
+	if status.DCD {
+		state |= 0x80
+	}
+	return state
+}
@@ -0,0 +1,118 @@
+// Copyright 2026 Arduino SA
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bytes"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRFC2217Parse(t *testing.T) {
+	c := newRfc2217Conn("/dev/ttyACM0", nil)
+	c.we[telnetBinary] = true
+	// data, an escaped IAC, an already enabled option and a subnegotiation split in two reads
+	require.Equal(t, []byte("ab\xff"), c.parse([]byte("a\xff\xfd\x00b\xff\xff\xff\xfa\x2c")))
+	require.Equal(t, telnetStateSubneg, c.state)
+	require.Equal(t, []byte("c"), c.parse([]byte("\x63\xff\xf0c")))
+	require.Equal(t, telnetStateData, c.state)
+
+	// a subnegotiation that never ends is dropped
+	c.parse(append([]byte("\xff\xfa\x2c"), bytes.Repeat([]byte{0x01}, maxSubnegLength)...))
+	require.Equal(t, telnetStateData, c.state)
+	require.Empty(t, c.subneg)
+	require.Equal(t, []byte("d"), c.parse([]byte("d")))
+}
+
+func TestRFC2217Server(t *testing.T) {
+	p := &serport{
+		portName:     "/dev/ttyRFC",
+		portConf:     newSerialConfig("/dev/ttyRFC", 9600),
+		portIo:       &replayPort{},
+		sendBuffered: make(chan serialWrite),
+		sendNoBuf:    make(chan serialWrite, 1),
+		done:         make(chan struct{}),
+	}
+	sh.Register(p)
+	startRFC2217Server("/dev/ttyRFC", 0)
+	rfc2217Servers.mu.Lock()
+	server := rfc2217Servers.servers["/dev/ttyRFC"]
+	rfc2217Servers.mu.Unlock()
+	require.NotNil(t, server)
+
+	conn, err := net.Dial("tcp", server.Addr())
+	require.NoError(t, err)
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(5 * time.Second))
+	read := func(n int) []byte {
+		buf := make([]byte, n)
+		_, err := io.ReadFull(conn, buf)
+		require.NoError(t, err)
+		return buf
+	}
+
+	// the server offers binary mode and the COM port option
+	require.Equal(t, []byte{
+		telnetIAC, telnetWILL, telnetBinary,
+		telnetIAC, telnetDO, telnetBinary,
+		telnetIAC, telnetWILL, telnetSGA,
+		telnetIAC, telnetWILL, telnetComPort,
+		telnetIAC, telnetDO, telnetComPort,
+	}, read(15))
+
+	// the client acknowledges and changes the baud rate, the server replies with the new one
+	_, err = conn.Write([]byte{
+		telnetIAC, telnetWILL, telnetComPort,
+		telnetIAC, telnetSB, telnetComPort, rfc2217SetBaudrate, 0x00, 0x01, 0xc2, 0x00, telnetIAC, telnetSE,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []byte{telnetIAC, telnetSB, telnetComPort, 101, 0x00, 0x01, 0xc2, 0x00, telnetIAC, telnetSE}, read(10))
+	p.confLock.Lock()
+	require.Equal(t, 115200, p.portConf.Baud)
+	p.confLock.Unlock()
+
+	// modem lines map onto the port
+	_, err = conn.Write([]byte{telnetIAC, telnetSB, telnetComPort, rfc2217SetControl, rfc2217ControlDTROff, telnetIAC, telnetSE})
+	require.NoError(t, err)
+	require.Equal(t, []byte{telnetIAC, telnetSB, telnetComPort, 105, rfc2217ControlDTROff, telnetIAC, telnetSE}, read(7))
+	p.confLock.Lock()
+	require.False(t, p.portConf.DtrOn)
+	p.confLock.Unlock()
+
+	// data is unescaped on the way to the port...
+	_, err = conn.Write([]byte("G0\xff\xff"))
+	require.NoError(t, err)
+	select {
+	case w := <-p.sendNoBuf:
+		require.Equal(t, []byte("G0\xff"), w.data)
+	case <-time.After(time.Second):
+		require.Fail(t, "data not written to the port")
+	}
+
+	// ...and escaped on the way to the client
+	serialStreams.Publish("/dev/ttyRFC", []byte("ok\xff"))
+	require.Equal(t, []byte("ok\xff\xff"), read(4))
+
+	// the server is stopped with the port
+	sh.Unregister(p)
+	_, err = conn.Read(make([]byte, 1))
+	require.ErrorIs(t, err, io.EOF)
+	require.False(t, rfc2217Servers.Stop("/dev/ttyRFC"))
+}
@@ -0,0 +1,494 @@
+// Copyright 2026 Arduino SA
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// the error codes of the JSON commands, as defined by JSON-RPC 2.0
+const (
+	rpcParseError     = -32700
+	rpcInvalidRequest = -32600
+	rpcMethodNotFound = -32601
+	rpcInvalidParams  = -32602
+	rpcServerError    = -32000
+)
+
+// rpcRequest is a JSON command, i.e. {"id":1,"method":"open","params":{"port":"COM3","baud":9600}}
+type rpcRequest struct {
+	ID     json.RawMessage `json:"id"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+}
+
+// rpcResponse is the reply to a JSON command, it carries either a result or an error
+type rpcResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  interface{}     `json:"result,omitempty"`
+	Error   *rpcError       `json:"error,omitempty"`
+}
+
+// rpcError is the error of a failed JSON command
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *rpcError) Error() string {
+	return e.Message
+}
+
+// invalidParams is returned by the methods when the params are not acceptable
+func invalidParams(msg string) *rpcError {
+	return &rpcError{Code: rpcInvalidParams, Message: msg}
+}
+
+// rpcMethod is a command that can be sent with the JSON envelope
+type rpcMethod struct {
+	call func(c *connection, params json.RawMessage) (interface{}, error)
+	// the method accesses the state of the connection, so it runs in the hub goroutine
+	hub bool
+}
+
+var rpcMethods map[string]rpcMethod
+
+func init() {
+	rpcMethods = map[string]rpcMethod{
+		"list":        {call: rpcList},
+		"open":        {call: rpcOpen},
+		"close":       {call: rpcClose},
+		"send":        {call: rpcSend},
+		"queue":       {call: rpcQueue},
+		"clearqueue":  {call: rpcClearQueue},
+		"setmode":     {call: rpcSetMode},
+		"setdtr":      {call: rpcSetModemLine("setdtr")},
+		"setrts":      {call: rpcSetModemLine("setrts")},
+		"break":       {call: rpcBreak},
+		"modemstatus": {call: rpcModemStatus},
+		"subscribe":   {call: rpcSubscribe(true), hub: true},
+		"unsubscribe": {call: rpcSubscribe(false), hub: true},
+		"buffers":     {call: rpcBuffers},
+		"aliases":     {call: rpcAliases},
+		"alias":       {call: rpcAlias},
+		"unalias":     {call: rpcUnalias},
+		"version":     {call: rpcVersion},
+		"hostname":    {call: rpcHostname},
+	}
+}
+
+// isRPCRequest tells apart the JSON commands from the text ones
+func isRPCRequest(data []byte) bool {
+	data = bytes.TrimSpace(data)
+	return len(data) > 0 && data[0] == '{'
+}
+
+// handleRPC executes a JSON command and replies only to the connection that sent it.
+// It must be called by the hub goroutine.
+func (h *hub) handleRPC(c *connection, data []byte) {
+	var req rpcRequest
+	if err := json.Unmarshal(data, &req); err != nil {
+		h.replyTo(c, rpcReply(nil, nil, &rpcError{Code: rpcParseError, Message: "Parse error: " + err.Error()}))
+		return
+	}
+	if len(req.ID) == 0 || req.Method == "" {
+		h.replyTo(c, rpcReply(req.ID, nil, &rpcError{Code: rpcInvalidRequest, Message: "Invalid request: the id and the method are required"}))
+		return
+	}
+	if *hibernate {
+		h.replyTo(c, rpcReply(req.ID, nil, &rpcError{Code: rpcServerError, Message: "The agent is hibernated"}))
+		return
+	}
+	method, ok := rpcMethods[strings.ToLower(req.Method)]
+	if !ok {
+		h.replyTo(c, rpcReply(req.ID, nil, &rpcError{Code: rpcMethodNotFound, Message: "Method not found: " + req.Method}))
+		return
+	}
+	params := req.Params
+	if len(params) == 0 || string(params) == "null" {
+		params = json.RawMessage("{}")
+	}
+
+	if method.hub {
+		result, err := method.call(c, params)
+		h.replyTo(c, rpcReply(req.ID, result, err))
+		return
+	}
+	go func() {
+		result, err := method.call(c, params)
+		h.reply <- connEvent{c, newEvent(eventReply, json.RawMessage(rpcReply(req.ID, result, err)))}
+	}()
+}
+
+// replyTo sends the reply to a JSON command, it must be called by the hub goroutine
+func (h *hub) replyTo(c *connection, reply []byte) {
+	h.sendToConnection(c, newEvent(eventReply, json.RawMessage(reply)))
+}
+
+// rpcReply encodes the reply to the command with the given id
+func rpcReply(id json.RawMessage, result interface{}, err error) []byte {
+	if id == nil {
+		id = json.RawMessage("null")
+	}
+	res := rpcResponse{JSONRPC: "2.0", ID: id}
+	if err != nil {
+		var rpcErr *rpcError
+		if !errors.As(err, &rpcErr) {
+			rpcErr = &rpcError{Code: rpcServerError, Message: err.Error()}
+		}
+		res.Error = rpcErr
+	} else if result == nil {
+		res.Result = true
+	} else {
+		res.Result = result
+	}
+	msg, _ := json.Marshal(res)
+	return msg
+}
+
+// decodeParams decodes the params object of a command into v, rejecting the unknown fields
+func decodeParams(params json.RawMessage, v interface{}) error {
+	if p := bytes.TrimSpace(params); len(p) == 0 || p[0] != '{' {
+		return invalidParams("Invalid params: the params must be an object")
+	}
+	dec := json.NewDecoder(bytes.NewReader(params))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		return invalidParams("Invalid params: " + err.Error())
+	}
+	return nil
+}
+
+// rpcPortParams are the params of the commands acting on a port
+type rpcPortParams struct {
+	Port string `json:"port"`
+}
+
+// openPort returns the open port named in the params
+func openPort(name string) (*serport, error) {
+	if name == "" {
+		return nil, invalidParams("Invalid params: the port is required")
+	}
+	port, ok := sh.FindPortByName(portAliases.Resolve(name))
+	if !ok {
+		return nil, errors.New("The serial port " + name + " is not open")
+	}
+	return port, nil
+}
+
+func rpcList(c *connection, params json.RawMessage) (interface{}, error) {
+	if err := decodeParams(params, &struct{}{}); err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"Ports": serialPorts.Items()}, nil
+}
+
+func rpcOpen(c *connection, params json.RawMessage) (interface{}, error) {
+	var p struct {
+		Port    string            `json:"port"`
+		Baud    int               `json:"baud"`
+		Buffer  string            `json:"buffer"`
+		Options map[string]string `json:"options"`
+	}
+	if err := decodeParams(params, &p); err != nil {
+		return nil, err
+	}
+	if p.Port == "" || p.Baud <= 0 {
+		return nil, invalidParams("Invalid params: the port and the baud rate are required")
+	}
+	if p.Buffer == "" {
+		p.Buffer = "default"
+	}
+	conf := newSerialConfig(p.Port, p.Baud)
+	for key, value := range p.Options {
+		if err := conf.SetOption(key + "=" + value); err != nil {
+			return nil, invalidParams("Invalid port configuration. " + err.Error())
+		}
+	}
+	// the failure is also notified to all the clients with an OpenFail message
+	if err := spHandlerOpen(conf, p.Buffer); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+func rpcClose(c *connection, params json.RawMessage) (interface{}, error) {
+	var p rpcPortParams
+	if err := decodeParams(params, &p); err != nil {
+		return nil, err
+	}
+	if p.Port == "" {
+		return nil, invalidParams("Invalid params: the port is required")
+	}
+	if !closePort(p.Port) {
+		return nil, errors.New("The serial port " + p.Port + " is not open")
+	}
+	return nil, nil
+}
+
+func rpcSend(c *connection, params json.RawMessage) (interface{}, error) {
+	var p struct {
+		Port string `json:"port"`
+		Data string `json:"data"`
+		Mode string `json:"mode"`
+		ID   string `json:"id"`
+	}
+	if err := decodeParams(params, &p); err != nil {
+		return nil, err
+	}
+	switch p.Mode {
+	case "":
+		p.Mode = "send"
+	case "send", "sendnobuf":
+	case "sendraw":
+		if _, err := base64.StdEncoding.DecodeString(p.Data); err != nil {
+			return nil, invalidParams("Invalid params: the data is not base64 encoded: " + err.Error())
+		}
+	default:
+		return nil, invalidParams("Invalid params: unsupported mode " + p.Mode)
+	}
+	port, err := openPort(p.Port)
+	if err != nil {
+		return nil, err
+	}
+	if err := port.Write(p.Data, p.Mode, p.ID, c); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+func rpcQueue(c *connection, params json.RawMessage) (interface{}, error) {
+	var p rpcPortParams
+	if err := decodeParams(params, &p); err != nil {
+		return nil, err
+	}
+	port, err := openPort(p.Port)
+	if err != nil {
+		return nil, err
+	}
+	return port.QueueStatus(), nil
+}
+
+func rpcClearQueue(c *connection, params json.RawMessage) (interface{}, error) {
+	var p rpcPortParams
+	if err := decodeParams(params, &p); err != nil {
+		return nil, err
+	}
+	port, err := openPort(p.Port)
+	if err != nil {
+		return nil, err
+	}
+	items, bytes := port.ClearQueue()
+	cleared := map[string]interface{}{
+		"Cmd":   "QueueCleared",
+		"Port":  port.portName,
+		"Items": items,
+		"Bytes": bytes,
+	}
+	broadcastEvent(eventPortQueue, cleared)
+	return cleared, nil
+}
+
+func rpcSetMode(c *connection, params json.RawMessage) (interface{}, error) {
+	var p struct {
+		Port    string            `json:"port"`
+		Baud    int               `json:"baud"`
+		Options map[string]string `json:"options"`
+	}
+	if err := decodeParams(params, &p); err != nil {
+		return nil, err
+	}
+	port, err := openPort(p.Port)
+	if err != nil {
+		return nil, err
+	}
+	conf := port.Config()
+	if p.Baud > 0 {
+		conf.Baud = p.Baud
+	}
+	for key, value := range p.Options {
+		if err := conf.SetModeOption(key + "=" + value); err != nil {
+			return nil, invalidParams("Invalid port configuration. " + err.Error())
+		}
+	}
+	if err := setPortMode(port, &conf); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+// rpcSetModemLine returns the method setting the given modem line, setdtr or setrts
+func rpcSetModemLine(line string) func(c *connection, params json.RawMessage) (interface{}, error) {
+	return func(c *connection, params json.RawMessage) (interface{}, error) {
+		var p struct {
+			Port string `json:"port"`
+			On   bool   `json:"on"`
+		}
+		if err := decodeParams(params, &p); err != nil {
+			return nil, err
+		}
+		port, err := openPort(p.Port)
+		if err != nil {
+			return nil, err
+		}
+		if line == "setdtr" {
+			err = port.SetDTR(p.On)
+		} else {
+			err = port.SetRTS(p.On)
+		}
+		if err != nil {
+			return nil, err
+		}
+		status, err := port.ModemStatus()
+		if err != nil {
+			return nil, err
+		}
+		broadcastEvent(eventPortModem, status)
+		return status, nil
+	}
+}
+
+func rpcBreak(c *connection, params json.RawMessage) (interface{}, error) {
+	var p struct {
+		Port     string `json:"port"`
+		Duration int    `json:"duration"` // milliseconds
+	}
+	if err := decodeParams(params, &p); err != nil {
+		return nil, err
+	}
+	duration := time.Duration(p.Duration) * time.Millisecond
+	if duration <= 0 || duration > maxBreakDuration {
+		return nil, invalidParams("Invalid params: the duration must be between 1 and " + strconv.FormatInt(maxBreakDuration.Milliseconds(), 10) + "ms")
+	}
+	port, err := openPort(p.Port)
+	if err != nil {
+		return nil, err
+	}
+	if err := port.Break(duration); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+func rpcModemStatus(c *connection, params json.RawMessage) (interface{}, error) {
+	var p struct {
+		Port  string `json:"port"`
+		Watch *bool  `json:"watch"`
+	}
+	if err := decodeParams(params, &p); err != nil {
+		return nil, err
+	}
+	port, err := openPort(p.Port)
+	if err != nil {
+		return nil, err
+	}
+	if p.Watch != nil {
+		if *p.Watch {
+			port.WatchModemStatus()
+		} else {
+			port.UnwatchModemStatus()
+		}
+	}
+	return port.ModemStatus()
+}
+
+// rpcSubscribe returns the subscribe or unsubscribe method, they run in the
+// hub goroutine like the text subscribe
+func rpcSubscribe(subscribe bool) func(c *connection, params json.RawMessage) (interface{}, error) {
+	return func(c *connection, params json.RawMessage) (interface{}, error) {
+		var p rpcPortParams
+		if err := decodeParams(params, &p); err != nil {
+			return nil, err
+		}
+		if p.Port == "" {
+			return nil, invalidParams("Invalid params: the port is required")
+		}
+		port := portAliases.Resolve(p.Port)
+		if subscribe {
+			c.subscribe(port)
+		} else {
+			c.unsubscribe(port)
+		}
+		return map[string]interface{}{"Subscriptions": c.subscribedPorts()}, nil
+	}
+}
+
+func rpcBuffers(c *connection, params json.RawMessage) (interface{}, error) {
+	if err := decodeParams(params, &struct{}{}); err != nil {
+		return nil, err
+	}
+	return bufferflowAlgorithms(), nil
+}
+
+func rpcAliases(c *connection, params json.RawMessage) (interface{}, error) {
+	if err := decodeParams(params, &struct{}{}); err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"Aliases": portAliases.List()}, nil
+}
+
+func rpcAlias(c *connection, params json.RawMessage) (interface{}, error) {
+	var p struct {
+		Port  string `json:"port"`
+		Alias string `json:"alias"`
+	}
+	if err := decodeParams(params, &p); err != nil {
+		return nil, err
+	}
+	item, ok := serialPorts.Item(p.Port)
+	if !ok {
+		return nil, errors.New("We could not find the serial port " + p.Port)
+	}
+	alias, err := portAliases.Set(p.Alias, item)
+	if err != nil {
+		return nil, invalidParams(err.Error())
+	}
+	serialPorts.List()
+	return alias, nil
+}
+
+func rpcUnalias(c *connection, params json.RawMessage) (interface{}, error) {
+	var p struct {
+		Alias string `json:"alias"`
+	}
+	if err := decodeParams(params, &p); err != nil {
+		return nil, err
+	}
+	found, err := portAliases.Remove(p.Alias)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, errors.New("There is no alias " + p.Alias)
+	}
+	serialPorts.List()
+	return nil, nil
+}
+
+func rpcVersion(c *connection, params json.RawMessage) (interface{}, error) {
+	return map[string]string{"Version": version}, nil
+}
+
+func rpcHostname(c *connection, params json.RawMessage) (interface{}, error) {
+	return map[string]string{"Hostname": *hostname}, nil
+}
@@ -0,0 +1,119 @@
+// Copyright 2026 Arduino SA
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsRPCRequest(t *testing.T) {
+	require.True(t, isRPCRequest([]byte(`{"id":1,"method":"list"}`)))
+	require.True(t, isRPCRequest([]byte(" \n{}")))
+	require.False(t, isRPCRequest([]byte("open COM3 9600")))
+	require.False(t, isRPCRequest([]byte("")))
+}
+
+func TestHandleRPC(t *testing.T) {
+	hub := &hub{connections: make(map[*connection]bool), reply: make(chan connEvent, 10)}
+	c := &connection{send: make(chan []byte, 10)}
+	other := &connection{send: make(chan []byte, 10)}
+	hub.connections[c] = true
+	hub.connections[other] = true
+
+	// call sends the command and returns the reply, delivering it as the hub does
+	call := func(request string) map[string]interface{} {
+		hub.handleRPC(c, []byte(request))
+		select {
+		case msg := <-c.send:
+			var res map[string]interface{}
+			require.NoError(t, json.Unmarshal(msg, &res))
+			return res
+		case m := <-hub.reply:
+			require.Equal(t, c, m.conn)
+			var res map[string]interface{}
+			require.NoError(t, json.Unmarshal(m.event.encode(false), &res))
+			return res
+		case <-time.After(time.Second):
+			require.FailNow(t, "no reply to "+request)
+			return nil
+		}
+	}
+	errorCode := func(res map[string]interface{}) float64 {
+		require.Nil(t, res["result"])
+		return res["error"].(map[string]interface{})["code"].(float64)
+	}
+
+	res := call(`{"id":1,"method":`)
+	require.Nil(t, res["id"])
+	require.Equal(t, float64(rpcParseError), errorCode(res))
+
+	res = call(`{"method":"list"}`)
+	require.Equal(t, float64(rpcInvalidRequest), errorCode(res))
+
+	res = call(`{"id":"a","method":"nope"}`)
+	require.Equal(t, "a", res["id"])
+	require.Equal(t, float64(rpcMethodNotFound), errorCode(res))
+
+	res = call(`{"id":2,"method":"subscribe","params":{"port":"/dev/ttyACM0","baud":9600}}`)
+	require.Equal(t, float64(rpcInvalidParams), errorCode(res))
+
+	res = call(`{"id":3,"method":"subscribe","params":{"port":"/dev/ttyACM0"}}`)
+	require.Equal(t, 3.0, res["id"])
+	require.Equal(t, "2.0", res["jsonrpc"])
+	require.Equal(t, map[string]interface{}{"Subscriptions": []interface{}{"/dev/ttyACM0"}}, res["result"])
+	require.True(t, c.isSubscribedTo("/dev/ttyACM0"))
+
+	res = call(`{"id":4,"method":"Version"}`)
+	require.Equal(t, map[string]interface{}{"Version": version}, res["result"])
+
+	res = call(`{"id":5,"method":"queue","params":{"port":"/dev/ttyNONE"}}`)
+	require.Equal(t, 5.0, res["id"])
+	require.Equal(t, float64(rpcServerError), errorCode(res))
+
+	res = call(`{"id":6,"method":"break","params":{"port":"/dev/ttyNONE","duration":60000}}`)
+	require.Equal(t, float64(rpcInvalidParams), errorCode(res))
+
+	p := &serport{
+		portName:     "/dev/ttyRPC",
+		portConf:     newSerialConfig("/dev/ttyRPC", 115200),
+		portIo:       &replayPort{},
+		BufferType:   "default",
+		sendBuffered: make(chan serialWrite, 10),
+		sendNoBuf:    make(chan serialWrite),
+		done:         make(chan struct{}),
+	}
+	sh.Register(p)
+	defer sh.Unregister(p)
+
+	res = call(`{"id":7,"method":"send","params":{"port":"/dev/ttyRPC","data":"G0 X1\n","id":"42"}}`)
+	require.Equal(t, true, res["result"])
+	w := <-p.sendBuffered
+	p.sendBufferedBytes.Add(-int64(len(w.data)))
+	require.Equal(t, serialWrite{data: []byte("G0 X1\n"), id: "42", conn: c}, w)
+
+	res = call(`{"id":8,"method":"send","params":{"port":"/dev/ttyRPC","data":"not base64!","mode":"sendraw"}}`)
+	require.Equal(t, float64(rpcInvalidParams), errorCode(res))
+
+	res = call(`{"id":9,"method":"queue","params":{"port":"/dev/ttyRPC"}}`)
+	require.Equal(t, "/dev/ttyRPC", res["result"].(map[string]interface{})["Port"])
+
+	// the replies are not delivered to the other connections
+	require.Empty(t, drain(other.send))
+}
@@ -19,6 +19,9 @@ package main
 
 import (
 	"encoding/json"
+	"fmt"
+	"net"
+	"path/filepath"
 	"slices"
 	"strconv"
 	"strings"
@@ -40,20 +43,30 @@ type serialhub struct {
 type SerialPortList struct {
 	Ports     []*SpPortItem
 	portsLock sync.Mutex
+	// ports hidden by portsDeviceFilter, they can't be opened
+	filtered map[string]bool
 }
 
 // SpPortItem is the serial port item
 type SpPortItem struct {
 	Name            string
+	Alias           string // stable name of the device, see portAliases
 	SerialNumber    string
 	DeviceClass     string
 	IsOpen          bool
 	IsPrimary       bool
 	Baud            int
+	DataBits        int
+	Parity          string
+	StopBits        string
+	FlowControl     string
 	BufferAlgorithm string
 	Ver             string
 	VendorID        string
 	ProductID       string
+
+	// virtual ports are not managed by the serial discovery
+	virtual bool
 }
 
 // serialPorts contains the ports attached to the machine
@@ -67,7 +80,7 @@ var sh = serialhub{
 func (sh *serialhub) Register(port *serport) {
 	sh.mu.Lock()
 	//log.Print("Registering a port: ", p.portConf.Name)
-	h.broadcastSys <- []byte("{\"Cmd\":\"Open\",\"Desc\":\"Got register/open on port.\",\"Port\":\"" + port.portConf.Name + "\",\"Baud\":" + strconv.Itoa(port.portConf.Baud) + ",\"BufferType\":\"" + port.BufferType + "\"}")
+	broadcastEvent(eventPortOpen, newSpPortModeEvent("Open", "Got register/open on port.", port.portConf, port.BufferType))
 	sh.ports[port.portName] = port
 	sh.mu.Unlock()
 }
@@ -76,14 +89,17 @@ func (sh *serialhub) Register(port *serport) {
 func (sh *serialhub) Unregister(port *serport) {
 	sh.mu.Lock()
 	//log.Print("Unregistering a port: ", p.portConf.Name)
-	h.broadcastSys <- []byte("{\"Cmd\":\"Close\",\"Desc\":\"Got unregister/close on port.\",\"Port\":\"" + port.portConf.Name + "\",\"Baud\":" + strconv.Itoa(port.portConf.Baud) + "}")
+	broadcastEvent(eventPortClose, SpPortEvent{Cmd: "Close", Desc: "Got unregister/close on port.", Port: port.portConf.Name, Baud: port.portConf.Baud})
 	delete(sh.ports, port.portName)
-	close(port.sendBuffered)
-	close(port.sendNoBuf)
+	serialStreams.CloseAll(port.portName)
+	rfc2217Servers.Stop(port.portName)
+	port.closeWrites()
 	sh.mu.Unlock()
 }
 
+// FindPortByName returns the open port with the given name or alias
 func (sh *serialhub) FindPortByName(portname string) (*serport, bool) {
+	portname = portAliases.Resolve(portname)
 	sh.mu.Lock()
 	defer sh.mu.Unlock()
 	port, ok := sh.ports[portname]
@@ -92,17 +108,25 @@ func (sh *serialhub) FindPortByName(portname string) (*serport, bool) {
 
 // List broadcasts a Json representation of the ports found
 func (sp *SerialPortList) List() {
+	h.broadcastSys <- sp.listEvent()
+}
+
+// ListTo sends the Json representation of the ports found only to the given connection
+func (sp *SerialPortList) ListTo(c *connection) {
+	h.reply <- connEvent{c, sp.listEvent()}
+}
+
+func (sp *SerialPortList) listEvent() hubEvent {
 	sp.portsLock.Lock()
+	sp.refreshAliases()
 	ls, err := json.MarshalIndent(sp, "", "\t")
 	sp.portsLock.Unlock()
 
 	if err != nil {
 		//log.Println(err)
-		h.broadcastSys <- []byte("Error creating json on port list " +
-			err.Error())
-	} else {
-		h.broadcastSys <- ls
+		return newEvent(eventError, "Error creating json on port list "+err.Error())
 	}
+	return newEvent(eventPortList, json.RawMessage(ls))
 }
 
 // Run is the main loop for port discovery and management
@@ -163,7 +187,31 @@ func (sp *SerialPortList) runSerialDiscovery() {
 func (sp *SerialPortList) reset() {
 	sp.portsLock.Lock()
 	defer sp.portsLock.Unlock()
-	sp.Ports = []*SpPortItem{}
+	sp.Ports = slices.DeleteFunc(sp.Ports, func(port *SpPortItem) bool {
+		return !port.virtual
+	})
+}
+
+// addVirtual adds a virtual port, emulating the given device, to the list
+func (sp *SerialPortList) addVirtual(portname string, device string) {
+	sp.portsLock.Lock()
+	defer sp.portsLock.Unlock()
+
+	count := 0
+	for _, port := range sp.Ports {
+		if port.virtual {
+			count++
+		}
+	}
+	sp.Ports = append(sp.Ports, &SpPortItem{
+		Name:         portname,
+		SerialNumber: "VIRTUAL" + strconv.Itoa(count),
+		DeviceClass:  "virtual-" + device,
+		VendorID:     virtualPortVID,
+		ProductID:    virtualPortPID,
+		Ver:          version,
+		virtual:      true,
+	})
 }
 
 func (sp *SerialPortList) add(addedPort *discovery.Port) {
@@ -186,6 +234,20 @@ func (sp *SerialPortList) add(addedPort *discovery.Port) {
 	sp.portsLock.Lock()
 	defer sp.portsLock.Unlock()
 
+	if !portsDeviceFilter.Allows(vid, pid, props.Get("serialNumber")) {
+		logrus.Debugf("ignoring port of a filtered device. port: %v\n", addedPort.Address)
+		if sp.filtered == nil {
+			sp.filtered = make(map[string]bool)
+		}
+		sp.filtered[addedPort.Address] = true
+		// the device may have been replaced by a filtered one
+		sp.Ports = slices.DeleteFunc(sp.Ports, func(oldPort *SpPortItem) bool {
+			return oldPort.Name == addedPort.Address
+		})
+		return
+	}
+	delete(sp.filtered, addedPort.Address)
+
 	// If the port is already in the list, just update the metadata...
 	for _, oldPort := range sp.Ports {
 		if oldPort.Name == addedPort.Address {
@@ -195,7 +257,8 @@ func (sp *SerialPortList) add(addedPort *discovery.Port) {
 			return
 		}
 	}
-	// ...otherwise, add it to the list
+	// ...otherwise, add it to the list and reopen it if it is a device that went away
+	go reconnector.PortAdded(addedPort.Address, props.Get("serialNumber"), vid, pid)
 	sp.Ports = append(sp.Ports, &SpPortItem{
 		Name:            addedPort.Address,
 		SerialNumber:    props.Get("serialNumber"),
@@ -213,19 +276,45 @@ func (sp *SerialPortList) remove(removedPort *discovery.Port) {
 	sp.portsLock.Lock()
 	defer sp.portsLock.Unlock()
 
+	delete(sp.filtered, removedPort.Address)
 	// Remove the port from the list
 	sp.Ports = slices.DeleteFunc(sp.Ports, func(oldPort *SpPortItem) bool {
 		return oldPort.Name == removedPort.Address
 	})
 }
 
-// MarkPortAsOpened marks a port as opened by the user
-func (sp *SerialPortList) MarkPortAsOpened(portname string) {
+// IsFiltered tells if the port can't be opened because of the allowPorts and denyPorts keys.
+// With an allowlist only the ports listed by the discovery, and allowed, can be opened:
+// other names, i.e. /dev/serial/by-id links, may lead to any device.
+func (sp *SerialPortList) IsFiltered(portname string) bool {
 	sp.portsLock.Lock()
 	defer sp.portsLock.Unlock()
+	if sp.filtered[portname] {
+		return true
+	}
+	if len(portsDeviceFilter.allow) == 0 || strings.HasPrefix(portname, replayPortPrefix) {
+		return false
+	}
 	port := sp.getPortByName(portname)
+	if port == nil {
+		return true
+	}
+	return !port.virtual && !portsDeviceFilter.Allows(port.VendorID, port.ProductID, port.SerialNumber)
+}
+
+// MarkPortAsOpened marks a port as opened by the user with the given configuration
+func (sp *SerialPortList) MarkPortAsOpened(conf *SerialConfig, bufferAlgorithm string) {
+	sp.portsLock.Lock()
+	defer sp.portsLock.Unlock()
+	port := sp.getPortByName(conf.Name)
 	if port != nil {
 		port.IsOpen = true
+		port.Baud = conf.Baud
+		port.DataBits = conf.DataBits
+		port.Parity = conf.Parity
+		port.StopBits = conf.StopBits
+		port.FlowControl = conf.FlowControl
+		port.BufferAlgorithm = bufferAlgorithm
 	}
 }
 
@@ -236,9 +325,55 @@ func (sp *SerialPortList) MarkPortAsClosed(portname string) {
 	port := sp.getPortByName(portname)
 	if port != nil {
 		port.IsOpen = false
+		port.Baud = 0
+		port.DataBits = 0
+		port.Parity = ""
+		port.StopBits = ""
+		port.FlowControl = ""
+		port.BufferAlgorithm = ""
 	}
 }
 
+// Items returns a copy of the ports found
+func (sp *SerialPortList) Items() []SpPortItem {
+	sp.portsLock.Lock()
+	defer sp.portsLock.Unlock()
+	sp.refreshAliases()
+	items := make([]SpPortItem, 0, len(sp.Ports))
+	for _, port := range sp.Ports {
+		items = append(items, *port)
+	}
+	return items
+}
+
+// Item returns a copy of the port with the given name or alias
+func (sp *SerialPortList) Item(portname string) (*SpPortItem, bool) {
+	portname = portAliases.Resolve(portname)
+	for _, item := range sp.Items() {
+		if item.Name == portname {
+			return &item, true
+		}
+	}
+	return nil, false
+}
+
+// refreshAliases updates the aliases of the ports, the caller must hold the lock
+func (sp *SerialPortList) refreshAliases() {
+	for _, port := range sp.Ports {
+		port.Alias = portAliases.AliasOf(port)
+	}
+}
+
+// Identity returns the serial number, VID and PID of the port, if known
+func (sp *SerialPortList) Identity(portname string) (serialNumber, vid, pid string) {
+	sp.portsLock.Lock()
+	defer sp.portsLock.Unlock()
+	if port := sp.getPortByName(portname); port != nil {
+		return port.SerialNumber, port.VendorID, port.ProductID
+	}
+	return "", "", ""
+}
+
 func (sp *SerialPortList) getPortByName(portname string) *SpPortItem {
 	for _, port := range sp.Ports {
 		if port.Name == portname {
@@ -249,21 +384,46 @@ func (sp *SerialPortList) getPortByName(portname string) *SpPortItem {
 }
 
 func spErr(err string) {
-	//log.Println("Sending err back: ", err)
-	//h.broadcastSys <- []byte(err)
-	h.broadcastSys <- []byte("{\"Error\" : \"" + err + "\"}")
+	broadcastEvent(eventError, map[string]string{"Error": err})
+}
+
+// spOpenFail notifies the clients that the port described by conf could not be opened.
+// holders are the processes keeping the port busy, if known.
+func spOpenFail(conf *SerialConfig, desc string, holders ...portHolder) {
+	fail := map[string]interface{}{
+		"Cmd":  "OpenFail",
+		"Desc": desc,
+		"Port": conf.Name,
+		"Baud": conf.Baud,
+	}
+	if len(holders) > 0 {
+		fail["Holders"] = holders
+	}
+	broadcastEvent(eventPortOpenFail, fail)
 }
 
-func spClose(portname string) {
+func spClose(c *connection, portname string) {
+	if !closePort(portname) {
+		replyErr(c, "We could not find the serial port "+portname+" that you were trying to close.")
+	}
+}
+
+// closePort closes the port, or stops waiting for it to reconnect.
+// It returns false if the port is neither open nor waiting.
+func closePort(portname string) bool {
+	portname = portAliases.Resolve(portname)
 	if myport, ok := sh.FindPortByName(portname); ok {
-		h.broadcastSys <- []byte("Closing serial port " + portname)
+		broadcastEvent(eventPortClose, "Closing serial port "+portname)
 		myport.Close()
+	} else if reconnector.Cancel(portname) {
+		broadcastEvent(eventReconnect, SpPortEvent{Cmd: "ReconnectCancel", Desc: "Stopped waiting for the device to come back.", Port: portname})
 	} else {
-		spErr("We could not find the serial port " + portname + " that you were trying to close.")
+		return false
 	}
+	return true
 }
 
-func spWrite(arg string) {
+func spWrite(c *connection, arg string) {
 	// we will get a string of comXX asdf asdf asdf
 	//log.Println("Inside spWrite arg: " + arg)
 	arg = strings.TrimPrefix(arg, " ")
@@ -272,10 +432,11 @@ func spWrite(arg string) {
 	if len(args) != 3 {
 		errstr := "Could not parse send command: " + arg
 		//log.Println(errstr)
-		spErr(errstr)
+		replyErr(c, errstr)
 		return
 	}
-	bufferingMode := args[0]
+	// the command can carry an id to get a WriteComplete message, i.e. send:42
+	bufferingMode, id, _ := strings.Cut(args[0], ":")
 	portname := strings.Trim(args[1], " ")
 	data := args[2]
 
@@ -286,7 +447,7 @@ func spWrite(arg string) {
 	port, ok := sh.FindPortByName(portname)
 	if !ok {
 		// we couldn't find the port, so send err
-		spErr("We could not find the serial port " + portname + " that you were trying to write to.")
+		replyErr(c, "We could not find the serial port "+portname+" that you were trying to write to.")
 		return
 	}
 
@@ -295,10 +456,340 @@ func spWrite(arg string) {
 	case "send", "sendnobuf", "sendraw":
 		// valid buffering mode, go ahead
 	default:
-		spErr("Unsupported send command:" + args[0] + ". Please specify a valid one")
+		replyErr(c, "Unsupported send command:"+args[0]+". Please specify a valid one")
 		return
 	}
 
 	// send it to the write channel
-	port.Write(data, bufferingMode)
+	if err := port.Write(data, bufferingMode, id, c); err != nil {
+		replyErr(c, "Error writing to "+portname+": "+err.Error())
+	}
+}
+
+func spSetMode(c *connection, arg string) {
+	// we will get a string of setmode comXX 115200 [parity=even ...]
+	args := strings.Fields(arg)
+	if len(args) < 3 {
+		replyErr(c, "You did not specify a port and baud rate in your setmode cmd")
+		return
+	}
+	portname := args[1]
+	baud, err := strconv.Atoi(args[2])
+	if err != nil {
+		replyErr(c, "Problem converting baud rate "+args[2])
+		return
+	}
+
+	port, ok := sh.FindPortByName(portname)
+	if !ok {
+		replyErr(c, "We could not find the serial port "+portname+" that you were trying to change the mode of.")
+		return
+	}
+
+	conf := port.Config()
+	conf.Baud = baud
+	for _, option := range args[3:] {
+		if err := conf.SetModeOption(option); err != nil {
+			replyErr(c, "Invalid port configuration. "+err.Error())
+			return
+		}
+	}
+
+	if err := setPortMode(port, &conf); err != nil {
+		replyErr(c, "Error changing the mode of "+portname+": "+err.Error())
+	}
+}
+
+// setPortMode changes the line settings of the open port and notifies the clients
+func setPortMode(port *serport, conf *SerialConfig) error {
+	if err := port.SetMode(conf); err != nil {
+		return err
+	}
+	broadcastEvent(eventPortOpen, newSpPortModeEvent("SetMode", "Got new mode on port.", conf, port.BufferType))
+	serialPorts.MarkPortAsOpened(conf, port.BufferType)
+	serialPorts.List()
+	return nil
+}
+
+// maxBreakDuration limits how long the writer can be kept busy by a break
+const maxBreakDuration = 10 * time.Second
+
+func spBreak(c *connection, arg string) {
+	// we will get a string of break comXX 250
+	args := strings.Fields(arg)
+	if len(args) != 3 {
+		replyErr(c, "You did not specify a port and a duration in milliseconds in your break cmd")
+		return
+	}
+	portname := args[1]
+	ms, err := strconv.Atoi(args[2])
+	if err != nil || ms <= 0 {
+		replyErr(c, "Problem converting break duration "+args[2])
+		return
+	}
+	duration := time.Duration(ms) * time.Millisecond
+	if duration > maxBreakDuration {
+		replyErr(c, "Break duration "+args[2]+"ms is too long, the maximum is "+strconv.FormatInt(maxBreakDuration.Milliseconds(), 10)+"ms")
+		return
+	}
+
+	port, ok := sh.FindPortByName(portname)
+	if !ok {
+		replyErr(c, "We could not find the serial port "+portname+" that you were trying to send a break to.")
+		return
+	}
+	if err := port.Break(duration); err != nil {
+		replyErr(c, "Error sending a break to "+portname+": "+err.Error())
+	}
+}
+
+func spRecord(c *connection, arg string) {
+	// we will get a string of record start|stop comXX
+	args := strings.Fields(arg)
+	if len(args) != 3 {
+		replyErr(c, "Could not parse "+arg+". Please specify start or stop and a port")
+		return
+	}
+	action := strings.ToLower(args[1])
+	portname := args[2]
+	port, ok := sh.FindPortByName(portname)
+	if !ok {
+		replyErr(c, "We could not find the serial port "+portname+" that you were trying to record.")
+		return
+	}
+
+	var recorder *serialRecorder
+	switch action {
+	case "start":
+		var err error
+		if recorder, err = port.StartRecording(); err != nil {
+			replyErr(c, "Error recording "+portname+": "+err.Error())
+			return
+		}
+	case "stop":
+		if recorder = port.StopRecording(); recorder == nil {
+			replyErr(c, "The serial port "+portname+" is not being recorded.")
+			return
+		}
+	default:
+		replyErr(c, "Unsupported record action:"+args[1]+". Please specify start or stop")
+		return
+	}
+	broadcastEvent(eventPortRecord, map[string]string{
+		"Cmd":  "Record" + strings.ToUpper(action[:1]) + action[1:],
+		"Port": portname,
+		"File": filepath.Base(recorder.Name()),
+	})
+}
+
+func spQueue(c *connection, arg string) {
+	// we will get a string of queue comXX or clearqueue comXX
+	args := strings.Fields(arg)
+	if len(args) != 2 {
+		replyErr(c, "You did not specify a port in your "+args[0]+" cmd")
+		return
+	}
+	portname := args[1]
+	port, ok := sh.FindPortByName(portname)
+	if !ok {
+		replyErr(c, "We could not find the serial port "+portname+" whose queue you were trying to access.")
+		return
+	}
+
+	if strings.ToLower(args[0]) == "clearqueue" {
+		items, bytes := port.ClearQueue()
+		broadcastEvent(eventPortQueue, map[string]interface{}{
+			"Cmd":   "QueueCleared",
+			"Port":  portname,
+			"Items": items,
+			"Bytes": bytes,
+		})
+	}
+	replyEvent(c, eventPortQueue, port.QueueStatus())
+}
+
+func spSetModemLine(c *connection, arg string) {
+	// we will get a string of setdtr comXX on
+	args := strings.Fields(arg)
+	if len(args) != 3 {
+		replyErr(c, "Could not parse "+arg+". Please specify a port and on or off")
+		return
+	}
+	line := strings.ToLower(args[0])
+	portname := args[1]
+	on, err := parseOnOff(args[2])
+	if err != nil {
+		replyErr(c, err.Error())
+		return
+	}
+
+	port, ok := sh.FindPortByName(portname)
+	if !ok {
+		replyErr(c, "We could not find the serial port "+portname+" that you were trying to control.")
+		return
+	}
+
+	switch line {
+	case "setdtr":
+		err = port.SetDTR(on)
+	case "setrts":
+		err = port.SetRTS(on)
+	default:
+		replyErr(c, "Unsupported modem line command:"+args[0]+". Please specify a valid one")
+		return
+	}
+	if err != nil {
+		replyErr(c, "Error setting the modem line on "+portname+": "+err.Error())
+		return
+	}
+	if err := broadcastModemStatus(port); err != nil {
+		replyErr(c, err.Error())
+	}
+}
+
+// broadcastModemStatus notifies all the clients of the modem status after a change of the lines
+func broadcastModemStatus(port *serport) error {
+	status, err := port.ModemStatus()
+	if err != nil {
+		return fmt.Errorf("Error reading the modem status of %s: %w", port.portName, err)
+	}
+	broadcastEvent(eventPortModem, status)
+	return nil
+}
+
+func spModemStatus(c *connection, arg string) {
+	// we will get a string of modemstatus comXX [watch|unwatch]
+	args := strings.Fields(arg)
+	if len(args) < 2 {
+		replyErr(c, "You did not specify a port to get the modem status of")
+		return
+	}
+	portname := args[1]
+	port, ok := sh.FindPortByName(portname)
+	if !ok {
+		replyErr(c, "We could not find the serial port "+portname+" that you were trying to get the modem status of.")
+		return
+	}
+
+	if len(args) > 2 {
+		switch strings.ToLower(args[2]) {
+		case "watch":
+			port.WatchModemStatus()
+		case "unwatch":
+			port.UnwatchModemStatus()
+		default:
+			replyErr(c, "Unsupported modemstatus option:"+args[2]+". Please specify watch or unwatch")
+			return
+		}
+	}
+
+	status, err := port.ModemStatus()
+	if err != nil {
+		replyErr(c, "Error reading the modem status of "+portname+": "+err.Error())
+		return
+	}
+	replyEvent(c, eventPortModem, status)
+}
+
+func spRFC2217(c *connection, arg string) {
+	// we will get a string of rfc2217 comXX 2217 or rfc2217 comXX off
+	args := strings.Fields(arg)
+	if len(args) != 3 {
+		replyErr(c, "Could not parse "+arg+". Please specify a port and a TCP port or off")
+		return
+	}
+	portname := portAliases.Resolve(args[1])
+	if strings.ToLower(args[2]) == "off" {
+		if !rfc2217Servers.Stop(portname) {
+			replyErr(c, "There is no RFC 2217 server running for "+portname)
+			return
+		}
+		broadcastEvent(eventPortRFC2217, SpPortEvent{Cmd: "RFC2217Stop", Desc: "Stopped sharing the port over RFC 2217.", Port: portname})
+		return
+	}
+	tcpPort, err := strconv.Atoi(args[2])
+	if err != nil || tcpPort <= 0 || tcpPort > 65535 {
+		replyErr(c, "Problem converting TCP port "+args[2])
+		return
+	}
+	if _, ok := sh.FindPortByName(portname); !ok {
+		replyErr(c, "We could not find the serial port "+portname+" that you were trying to share.")
+		return
+	}
+	if err := startRFC2217Server(portname, tcpPort); err != nil {
+		replyErr(c, err.Error())
+	}
+}
+
+// startRFC2217Server shares the open port over TCP and notifies the clients
+func startRFC2217Server(portname string, tcpPort int) error {
+	s, err := rfc2217Servers.Start(portname, net.JoinHostPort(*address, strconv.Itoa(tcpPort)))
+	if err != nil {
+		return fmt.Errorf("Error starting the RFC 2217 server for %s: %w", portname, err)
+	}
+	broadcastEvent(eventPortRFC2217, map[string]interface{}{
+		"Cmd":     "RFC2217",
+		"Desc":    "Sharing the port over RFC 2217.",
+		"Port":    portname,
+		"Address": s.Addr(),
+	})
+	return nil
+}
+
+func spAlias(c *connection, arg string) {
+	// we will get a string of alias comXX printer, unalias printer or aliases
+	args := strings.Fields(arg)
+	switch strings.ToLower(args[0]) {
+	case "alias":
+		if len(args) != 3 {
+			replyErr(c, "Could not parse "+arg+". Please specify a port and an alias")
+			return
+		}
+		port, ok := serialPorts.Item(args[1])
+		if !ok {
+			replyErr(c, "We could not find the serial port "+args[1]+" that you were trying to give an alias.")
+			return
+		}
+		if _, err := portAliases.Set(args[2], port); err != nil {
+			replyErr(c, "Error setting the alias of "+args[1]+": "+err.Error())
+			return
+		}
+		serialPorts.List()
+	case "unalias":
+		if len(args) != 2 {
+			replyErr(c, "Could not parse "+arg+". Please specify an alias")
+			return
+		}
+		found, err := portAliases.Remove(args[1])
+		if err != nil {
+			replyErr(c, "Error removing the alias "+args[1]+": "+err.Error())
+			return
+		}
+		if !found {
+			replyErr(c, "There is no alias "+args[1])
+			return
+		}
+		serialPorts.List()
+	}
+	aliases := map[string]interface{}{
+		"Cmd":     "Aliases",
+		"Aliases": portAliases.List(),
+	}
+	// only the changes of the aliases are notified to everyone
+	if strings.ToLower(args[0]) == "aliases" {
+		replyEvent(c, eventAliases, aliases)
+		return
+	}
+	broadcastEvent(eventAliases, aliases)
+}
+
+// parseOnOff parses the state of a switch, i.e. a modem line
+func parseOnOff(s string) (bool, error) {
+	switch strings.ToLower(s) {
+	case "on", "true", "1":
+		return true, nil
+	case "off", "false", "0":
+		return false, nil
+	}
+	return false, fmt.Errorf("invalid value %q, expected on or off", s)
 }
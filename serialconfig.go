@@ -0,0 +1,191 @@
+// Copyright 2026 Arduino SA
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	serial "go.bug.st/serial"
+)
+
+// SerialConfig is the serial port configuration
+type SerialConfig struct {
+	Name          string
+	Baud          int
+	DataBits      int    // 5, 6, 7 or 8
+	Parity        string // none, odd, even, mark or space
+	StopBits      string // 1, 1.5 or 2
+	FlowControl   string // none, rtscts or xonxoff
+	AutoReconnect bool   // reopen the port when the device comes back after a reset
+	RFC2217Port   int    // TCP port of the RFC 2217 server sharing the port, 0 if disabled
+	RtsOn         bool
+	DtrOn         bool
+
+	// parameters of the buffer algorithm, see BufferflowAlgorithm
+	BufferParams map[string]string
+}
+
+var serialParities = map[string]serial.Parity{
+	"none":  serial.NoParity,
+	"odd":   serial.OddParity,
+	"even":  serial.EvenParity,
+	"mark":  serial.MarkParity,
+	"space": serial.SpaceParity,
+}
+
+var serialStopBits = map[string]serial.StopBits{
+	"1":   serial.OneStopBit,
+	"1.5": serial.OnePointFiveStopBits,
+	"2":   serial.TwoStopBits,
+}
+
+// newSerialConfig returns a configuration with the usual 8N1 framing and no flow control
+func newSerialConfig(name string, baud int) *SerialConfig {
+	return &SerialConfig{
+		Name:        name,
+		Baud:        baud,
+		DataBits:    8,
+		Parity:      "none",
+		StopBits:    "1",
+		FlowControl: "none",
+		RtsOn:       true,
+		DtrOn:       true,
+	}
+}
+
+// SetOption sets a single "key=value" option coming from the open command
+func (c *SerialConfig) SetOption(option string) error {
+	key, value, ok := strings.Cut(option, "=")
+	if !ok {
+		return fmt.Errorf("invalid option %q, expected key=value", option)
+	}
+	// the parameters of the buffer algorithms can be case sensitive, i.e. regular expressions
+	rawValue := value
+	value = strings.ToLower(value)
+	switch strings.ToLower(key) {
+	case "databits":
+		dataBits, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("invalid data bits %q", value)
+		}
+		c.DataBits = dataBits
+	case "parity":
+		c.Parity = value
+	case "stopbits":
+		c.StopBits = value
+	case "flowcontrol":
+		c.FlowControl = value
+	case "rfc2217":
+		tcpPort, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("invalid RFC 2217 TCP port %q", value)
+		}
+		c.RFC2217Port = tcpPort
+	case "autoreconnect":
+		autoReconnect, err := parseOnOff(value)
+		if err != nil {
+			return err
+		}
+		c.AutoReconnect = autoReconnect
+	default:
+		name, ok := bufferflowParameterName(key)
+		if !ok {
+			return fmt.Errorf("unknown option %q", strings.ToLower(key))
+		}
+		key = name
+		// validated with the buffer algorithm when the port is opened
+		if c.BufferParams == nil {
+			c.BufferParams = make(map[string]string)
+		}
+		c.BufferParams[key] = rawValue
+	}
+	return nil
+}
+
+// setModeOptions are the options that setmode can apply to an open port
+var setModeOptions = map[string]bool{"databits": true, "parity": true, "stopbits": true, "flowcontrol": true}
+
+// SetModeOption sets a single "key=value" option coming from the setmode command,
+// only the line settings can be changed while the port is open
+func (c *SerialConfig) SetModeOption(option string) error {
+	key, _, _ := strings.Cut(option, "=")
+	if !setModeOptions[strings.ToLower(key)] {
+		return fmt.Errorf("option %q cannot be changed on an open port", key)
+	}
+	return c.SetOption(option)
+}
+
+// Validate checks that the configuration describes a frame the serial driver can handle
+func (c *SerialConfig) Validate() error {
+	if c.Baud <= 0 {
+		return fmt.Errorf("invalid baud rate %d", c.Baud)
+	}
+	if c.DataBits < 5 || c.DataBits > 8 {
+		return fmt.Errorf("invalid data bits %d, must be between 5 and 8", c.DataBits)
+	}
+	if _, ok := serialParities[c.Parity]; !ok {
+		return fmt.Errorf("invalid parity %q, must be one of none, odd, even, mark, space", c.Parity)
+	}
+	if _, ok := serialStopBits[c.StopBits]; !ok {
+		return fmt.Errorf("invalid stop bits %q, must be one of 1, 1.5, 2", c.StopBits)
+	}
+	// UARTs only generate 1.5 stop bits for 5 bits characters, and use it in place of 2 stop bits.
+	// Depending on the driver the setting is either 1.5 or 2 stop bits, see serialOnePointFiveStopBits
+	if c.StopBits == "1.5" {
+		if !serialOnePointFiveStopBits {
+			return fmt.Errorf("1.5 stop bits are not supported on this system, use 2 stop bits with 5 data bits instead")
+		}
+		if c.DataBits != 5 {
+			return fmt.Errorf("1.5 stop bits can only be used with 5 data bits")
+		}
+	}
+	if c.StopBits == "2" && c.DataBits == 5 && serialOnePointFiveStopBits {
+		return fmt.Errorf("2 stop bits cannot be used with 5 data bits, use 1.5 instead")
+	}
+	switch c.FlowControl {
+	case "none", "rtscts", "xonxoff":
+	default:
+		return fmt.Errorf("invalid flow control %q, must be one of none, rtscts, xonxoff", c.FlowControl)
+	}
+	if c.RFC2217Port < 0 || c.RFC2217Port > 65535 {
+		return fmt.Errorf("invalid RFC 2217 TCP port %d", c.RFC2217Port)
+	}
+	return nil
+}
+
+// Mode returns the go.bug.st/serial mode matching the configuration
+func (c *SerialConfig) Mode() (*serial.Mode, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	return &serial.Mode{
+		BaudRate: c.Baud,
+		DataBits: c.DataBits,
+		Parity:   serialParities[c.Parity],
+		StopBits: serialStopBits[c.StopBits],
+	}, nil
+}
+
+// SameMode returns true if both configurations use the same line settings
+func (c *SerialConfig) SameMode(o *SerialConfig) bool {
+	return c.Baud == o.Baud &&
+		c.DataBits == o.DataBits &&
+		c.Parity == o.Parity &&
+		c.StopBits == o.StopBits &&
+		c.FlowControl == o.FlowControl
+}
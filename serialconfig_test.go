@@ -0,0 +1,99 @@
+// Copyright 2026 Arduino SA
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	serial "go.bug.st/serial"
+)
+
+func TestSerialConfigOptions(t *testing.T) {
+	conf := newSerialConfig("/dev/ttyACM0", 9600)
+	for _, option := range []string{"databits=7", "parity=Odd", "StopBits=2", "flowcontrol=rtscts"} {
+		require.NoError(t, conf.SetOption(option))
+	}
+	mode, err := conf.Mode()
+	require.NoError(t, err)
+	require.Equal(t, &serial.Mode{BaudRate: 9600, DataBits: 7, Parity: serial.OddParity, StopBits: serial.TwoStopBits}, mode)
+	require.Equal(t, "rtscts", conf.FlowControl)
+
+	require.Error(t, conf.SetOption("parity"))
+	require.Error(t, conf.SetOption("databits=eight"))
+	require.Error(t, conf.SetOption("speed=9600"))
+	// buffer parameters are validated by the buffer algorithm
+	require.NoError(t, conf.SetOption("Terminator=CRLF"))
+	// the names of the patterns keep their case
+	require.NoError(t, conf.SetOption("Match.READY=^READY$"))
+	require.Equal(t, map[string]string{"terminator": "CRLF", "match.READY": "^READY$"}, conf.BufferParams)
+}
+
+func TestSerialConfigValidate(t *testing.T) {
+	tests := []struct {
+		options []string
+		valid   bool
+	}{
+		{nil, true},
+		{[]string{"parity=even"}, true},
+		{[]string{"databits=9"}, false},
+		{[]string{"parity=unknown"}, false},
+		{[]string{"stopbits=3"}, false},
+		{[]string{"stopbits=1.5"}, false},
+		// 5 data bits with more than one stop bit are set as 1.5 or 2 stop bits, depending on the driver
+		{[]string{"databits=5", "stopbits=1.5"}, serialOnePointFiveStopBits},
+		{[]string{"databits=5", "stopbits=2"}, !serialOnePointFiveStopBits},
+		{[]string{"databits=6", "stopbits=2"}, true},
+		{[]string{"flowcontrol=dsrdtr"}, false},
+		{[]string{"flowcontrol=xonxoff"}, true},
+	}
+	for _, test := range tests {
+		conf := newSerialConfig("/dev/ttyACM0", 115200)
+		for _, option := range test.options {
+			require.NoError(t, conf.SetOption(option))
+		}
+		if test.valid {
+			require.NoError(t, conf.Validate(), test.options)
+		} else {
+			require.Error(t, conf.Validate(), test.options)
+		}
+	}
+}
+
+func TestSerialConfigSetModeOption(t *testing.T) {
+	conf := newSerialConfig("/dev/ttyACM0", 115200)
+	require.NoError(t, conf.SetModeOption("parity=even"))
+	require.NoError(t, conf.SetModeOption("DataBits=7"))
+	require.Equal(t, "even", conf.Parity)
+	require.Equal(t, 7, conf.DataBits)
+	for _, option := range []string{"interval=5", "autoreconnect=on", "rfc2217=2217", "unknown=1"} {
+		require.Error(t, conf.SetModeOption(option), option)
+	}
+	require.Nil(t, conf.BufferParams)
+	require.False(t, conf.AutoReconnect)
+	require.Zero(t, conf.RFC2217Port)
+}
+
+func TestSerportConfigIsACopy(t *testing.T) {
+	conf := newSerialConfig("/dev/ttyACM0", 115200)
+	require.NoError(t, conf.SetOption("interval=5"))
+	p := &serport{portConf: conf}
+	copied := p.Config()
+	copied.BufferParams["interval"] = "50"
+	copied.Parity = "odd"
+	require.Equal(t, "5", conf.BufferParams["interval"])
+	require.Equal(t, "none", conf.Parity)
+}
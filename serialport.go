@@ -18,8 +18,14 @@ package main
 import (
 	"bytes"
 	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
+	"maps"
+	"os"
 	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -29,18 +35,10 @@ import (
 	serial "go.bug.st/serial"
 )
 
-// SerialConfig is the serial port configuration
-type SerialConfig struct {
-	Name  string
-	Baud  int
-	RtsOn bool
-	DtrOn bool
-}
-
 type serport struct {
 	// The serial port connection.
 	portConf *SerialConfig
-	portIo   io.ReadWriteCloser
+	portIo   serial.Port
 	portName string
 
 	// Keep track of whether we're being actively closed
@@ -50,18 +48,48 @@ type serport struct {
 	isClosingDueToError bool
 
 	// buffered channel containing up to 25600 outbound messages.
-	sendBuffered chan string
+	sendBuffered chan serialWrite
+
+	// size of the messages waiting in sendBuffered
+	sendBufferedBytes atomic.Int64
 
 	// unbuffered channel of outbound messages that bypass internal serial port buffer
-	sendNoBuf chan []byte
+	sendNoBuf chan serialWrite
 
 	// channel containing raw base64 encoded binary data (outbound messages)
-	sendRaw chan string
+	sendRaw chan serialWrite
+
+	// channel of break requests, they are served by writerNoBuf between two writes
+	sendBreak chan time.Duration
+
+	// closed by stop when the port can't be written anymore
+	done     chan struct{}
+	stopOnce sync.Once
+
+	// held for reading while queueing a write, Unregister holds it for
+	// writing to close the write channels once nobody is sending to them
+	writeLock sync.RWMutex
+	closed    bool
+
+	// pauses writerNoBuf when the flow control is xonxoff, nil otherwise
+	xonxoff *softwareFlowControl
 
 	// Do we have an extra channel/thread to watch our buffer?
 	BufferType string
 	//bufferwatcher *BufferflowDummypause
 	bufferwatcher Bufferflow
+
+	// protects portConf and the modem status watcher
+	confLock         sync.Mutex
+	modemWatcherDone chan bool
+
+	// identity of the device, used to find it again when autoreconnect is enabled
+	serialNumber string
+	vendorID     string
+	productID    string
+
+	// records the traffic of the port, if not nil
+	recorder atomic.Pointer[serialRecorder]
 }
 
 // SpPortMessage is the serial port message
@@ -76,7 +104,9 @@ type SpPortMessageRaw struct {
 	D []byte // the data, i.e. G0 X0 Y0
 }
 
-func (p *serport) reader(buftype string) {
+// reader reads from the port and gives the data to the bufferflow.
+// If splitUTF8 is true the data is split on UTF-8 characters.
+func (p *serport) reader(splitUTF8 bool) {
 
 	timeCheckOpen := time.Now()
 	var bufferedCh bytes.Buffer
@@ -85,34 +115,34 @@ func (p *serport) reader(buftype string) {
 	for {
 		n, err := p.portIo.Read(serialBuffer)
 		bufferPart := serialBuffer[:n]
+		if n > 0 {
+			p.record("read", bufferPart)
+			// the flow control bytes are not meant for the clients
+			if p.xonxoff != nil {
+				bufferPart = p.xonxoff.Filter(bufferPart)
+			}
+			serialStreams.Publish(p.portName, bufferPart)
+		}
 
 		//if we detect that port is closing, break out of this for{} loop.
 		if p.isClosing.Load() {
 			strmsg := "Shutting down reader on " + p.portConf.Name
 			log.Println(strmsg)
-			h.broadcastSys <- []byte(strmsg)
+			broadcastEvent(eventPortClose, strmsg)
 			break
 		}
 
 		// read can return legitimate bytes as well as an error
 		// so process the n bytes red, if n > 0
-		if n > 0 && err == nil {
+		if len(bufferPart) > 0 && err == nil {
 
-			log.Print("Read " + strconv.Itoa(n) + " bytes ch: " + string(bufferPart[:n]))
+			log.Print("Read " + strconv.Itoa(len(bufferPart)) + " bytes ch: " + string(bufferPart))
 
 			data := ""
-			switch buftype {
-			case "timedraw", "timed":
-				data = string(bufferPart[:n])
-				// give the data to our bufferflow so it can do it's work
-				// to read/translate the data to see if it wants to block
-				// writes to the serialport. each bufferflow type will decide
-				// this on its own based on its logic
-				p.bufferwatcher.OnIncomingData(data)
-			case "default": // the bufferbuftype is actually called default 🤷‍♂️
+			if splitUTF8 {
 				// save the left out bytes for the next iteration due to UTF-8 encoding
-				bufferPart = append(bufferedCh.Bytes(), bufferPart[:n]...)
-				n += len(bufferedCh.Bytes())
+				bufferPart = append(bufferedCh.Bytes(), bufferPart...)
+				n := len(bufferPart)
 				bufferedCh.Reset()
 				for i, w := 0, 0; i < n; i += w {
 					runeValue, width := utf8.DecodeRune(bufferPart[i:n]) // try to decode the first i bytes in the buffer (UTF8 runes do not have a fixed length)
@@ -126,10 +156,14 @@ func (p *serport) reader(buftype string) {
 					data += string(runeValue)
 					w = width
 				}
-				p.bufferwatcher.OnIncomingData(data)
-			default:
-				log.Panicf("unknown buffer type %s", buftype)
+			} else {
+				data = string(bufferPart)
 			}
+			// give the data to our bufferflow so it can do it's work
+			// to read/translate the data to see if it wants to block
+			// writes to the serialport. each bufferflow type will decide
+			// this on its own based on its logic
+			p.bufferwatcher.OnIncomingData(data)
 		}
 
 		// double check that we got characters in the buffer
@@ -144,15 +178,14 @@ func (p *serport) reader(buftype string) {
 			if err == io.EOF || err == io.ErrUnexpectedEOF {
 				// hit end of file
 				log.Println("Hit end of file on serial port")
-				h.broadcastSys <- []byte("{\"Cmd\":\"OpenFail\",\"Desc\":\"Got EOF (End of File) on port which usually means another app other than Serial Port JSON Server is locking your port. " + err.Error() + "\",\"Port\":\"" + p.portConf.Name + "\",\"Baud\":" + strconv.Itoa(p.portConf.Baud) + "}")
+				broadcastEvent(eventPortOpenFail, SpPortEvent{Cmd: "OpenFail", Desc: "Got EOF (End of File) on port which usually means another app other than Serial Port JSON Server is locking your port. " + err.Error(), Port: p.portConf.Name, Baud: p.portConf.Baud})
 
 			}
 
 			if err != nil {
 				log.Println(err)
-				h.broadcastSys <- []byte("Error reading on " + p.portConf.Name + " " +
-					err.Error() + " Closing port.")
-				h.broadcastSys <- []byte("{\"Cmd\":\"OpenFail\",\"Desc\":\"Got error reading on port. " + err.Error() + "\",\"Port\":\"" + p.portConf.Name + "\",\"Baud\":" + strconv.Itoa(p.portConf.Baud) + "}")
+				broadcastEvent(eventPortError, "Error reading on "+p.portConf.Name+" "+err.Error()+" Closing port.")
+				broadcastEvent(eventPortOpenFail, SpPortEvent{Cmd: "OpenFail", Desc: "Got error reading on port. " + err.Error(), Port: p.portConf.Name, Baud: p.portConf.Baud})
 				p.isClosingDueToError = true
 				break
 			}
@@ -173,19 +206,121 @@ func (p *serport) reader(buftype string) {
 	}
 }
 
+// serialWrite is a chunk of outbound data
+type serialWrite struct {
+	data []byte
+	// id supplied by the client, if not empty a WriteComplete
+	// message is sent once the data has been written
+	id string
+	// connection that sent the data, it gets the WriteComplete message.
+	// Without a connection the message goes to the subscribers of the port.
+	conn *connection
+	// the data went through the buffered queue
+	buffered bool
+}
+
+// SpWriteComplete is the message sent when a write with an id has been completed
+type SpWriteComplete struct {
+	Cmd   string
+	Port  string
+	ID    string
+	Bytes int    // number of bytes written
+	Error string `json:",omitempty"`
+}
+
 // Write data to the serial port.
-func (p *serport) Write(data string, sendMode string) {
+// If id is not empty a WriteComplete message is sent to c, if any, when done.
+// It returns errPortClosed if the port is closing.
+func (p *serport) Write(data string, sendMode string, id string, c *connection) error {
+	w := serialWrite{data: []byte(data), id: id, conn: c}
+	p.writeLock.RLock()
+	defer p.writeLock.RUnlock()
+	if p.closed {
+		return errPortClosed
+	}
+
+	var ch chan serialWrite
 	// if user sent in the commands as one text mode line
 	switch sendMode {
 	case "send":
-		p.sendBuffered <- data
+		ch = p.sendBuffered
+		// realtime commands can't wait behind the queue, i.e. a feed hold
+		if grbl, ok := p.bufferwatcher.(*BufferflowGrbl); ok && grbl.IsRealtimeCommand(data) {
+			ch = p.sendNoBuf
+		}
 	case "sendnobuf":
-		p.sendNoBuf <- []byte(data)
+		ch = p.sendNoBuf
 	case "sendraw":
-		p.sendRaw <- data
+		ch = p.sendRaw
+	default:
+		return fmt.Errorf("unsupported send mode %q", sendMode)
+	}
+	if ch == p.sendBuffered {
+		p.sendBufferedBytes.Add(int64(len(w.data)))
+	}
+	select {
+	case ch <- w:
+		return nil
+	case <-p.done:
+		if ch == p.sendBuffered {
+			p.sendBufferedBytes.Add(-int64(len(w.data)))
+		}
+		return errPortClosed
 	}
 }
 
+// closeWrites closes the write channels, the following writes fail with errPortClosed
+func (p *serport) closeWrites() {
+	// release the writes waiting for the writers
+	p.stop()
+	p.writeLock.Lock()
+	defer p.writeLock.Unlock()
+	p.closed = true
+	close(p.sendBuffered)
+	close(p.sendNoBuf)
+}
+
+// writeComplete notifies the client that sent the write, if it has an id, that it has been completed
+func (p *serport) writeComplete(w serialWrite, n int, err error) {
+	if w.id == "" {
+		return
+	}
+	m := SpWriteComplete{Cmd: "WriteComplete", Port: p.portName, ID: w.id, Bytes: n}
+	if err != nil {
+		m.Error = err.Error()
+	}
+	if w.conn != nil {
+		replyEvent(w.conn, eventPortWrite, m)
+		return
+	}
+	msg, _ := json.Marshal(m)
+	h.broadcastPort <- portMessage{p.portName, eventPortWrite, msg}
+}
+
+// errPortClosed is returned by the operations on a port that is closing
+var errPortClosed = errors.New("port closed")
+
+// breakQueueTimeout limits how long Break waits for the writer, i.e. while it is paused by XOFF
+const breakQueueTimeout = 5 * time.Second
+
+// Break queues a break condition on the serial port. It is sent by the
+// writerNoBuf goroutine so it never interrupts a write in progress.
+func (p *serport) Break(duration time.Duration) error {
+	select {
+	case p.sendBreak <- duration:
+		return nil
+	case <-p.done:
+		return errPortClosed
+	case <-time.After(breakQueueTimeout):
+		return errors.New("the port is busy writing")
+	}
+}
+
+// stop tells the goroutines waiting to queue something to the writers that the port is closing
+func (p *serport) stop() {
+	p.stopOnce.Do(func() { close(p.done) })
+}
+
 // this method runs as its own thread because it's instantiated
 // as a "go" method. so if it blocks inside, it is ok
 func (p *serport) writerBuffered() {
@@ -201,16 +336,34 @@ func (p *serport) writerBuffered() {
 
 	// this for loop blocks on p.sendBuffered until that channel
 	// sees something come in
-	for data := range p.sendBuffered {
+	for w := range p.sendBuffered {
+		p.sendBufferedBytes.Add(-int64(len(w.data)))
+
+		// wait for the device to have room for the data
+		if !p.bufferwatcher.BlockUntilReady(w.data) {
+			p.writeComplete(w, 0, errPortClosed)
+			continue
+		}
 
 		// send to the non-buffered serial port writer
 		//log.Println("About to send to p.sendNoBuf channel")
-		p.sendNoBuf <- []byte(data)
+		w.buffered = true
+		p.writeLock.RLock()
+		if p.closed {
+			p.writeComplete(w, 0, errPortClosed)
+		} else {
+			select {
+			case p.sendNoBuf <- w:
+			case <-p.done:
+				p.writeComplete(w, 0, errPortClosed)
+			}
+		}
+		p.writeLock.RUnlock()
 
 	}
 	msgstr := "writerBuffered just got closed. make sure you make a new one. port:" + p.portConf.Name
 	log.Println(msgstr)
-	h.broadcastSys <- []byte(msgstr)
+	broadcastEvent(eventPortClose, msgstr)
 }
 
 // this method runs as its own thread because it's instantiated
@@ -218,30 +371,75 @@ func (p *serport) writerBuffered() {
 func (p *serport) writerNoBuf() {
 	// this for loop blocks on p.send until that channel
 	// sees something come in
-	for data := range p.sendNoBuf {
+Loop:
+	for {
+		select {
+		case w, ok := <-p.sendNoBuf:
+			if !ok {
+				break Loop
+			}
+			data := w.data
 
-		// if we get here, we were able to write successfully
-		// to the serial port because it blocks until it can write
+			// wait for the device to send XON
+			if p.xonxoff != nil && !p.xonxoff.Wait() {
+				p.writeComplete(w, 0, errPortClosed)
+				continue
+			}
 
-		// FINALLY, OF ALL THE CODE IN THIS PROJECT
-		// WE TRULY/FINALLY GET TO WRITE TO THE SERIAL PORT!
-		n2, err := p.portIo.Write(data)
+			// if we get here, we were able to write successfully
+			// to the serial port because it blocks until it can write
 
-		log.Print("Just wrote ", n2, " bytes to serial: ", string(data))
-		if err != nil {
-			errstr := "Error writing to " + p.portConf.Name + " " + err.Error() + " Closing port."
-			log.Print(errstr)
-			h.broadcastSys <- []byte(errstr)
-			break
+			// FINALLY, OF ALL THE CODE IN THIS PROJECT
+			// WE TRULY/FINALLY GET TO WRITE TO THE SERIAL PORT!
+			n2, err := p.portIo.Write(data)
+			if n2 > 0 {
+				p.record("write", data[:n2])
+				if grbl, ok := p.bufferwatcher.(*BufferflowGrbl); ok && !w.buffered {
+					grbl.OnUnbufferedWrite(data[:n2])
+				}
+			}
+
+			log.Print("Just wrote ", n2, " bytes to serial: ", string(data))
+			p.writeComplete(w, n2, err)
+			if err != nil {
+				errstr := "Error writing to " + p.portConf.Name + " " + err.Error() + " Closing port."
+				log.Print(errstr)
+				broadcastEvent(eventPortError, errstr)
+				break Loop
+			}
+		case duration := <-p.sendBreak:
+			p.sendBreakCondition(duration)
 		}
 	}
+	p.stop()
 	msgstr := "Shutting down writer on " + p.portConf.Name
 	log.Println(msgstr)
-	h.broadcastSys <- []byte(msgstr)
+	broadcastEvent(eventPortClose, msgstr)
 	p.portIo.Close()
 	serialPorts.List()
 }
 
+// sendBreakCondition waits for the data already written to leave the port
+// and then holds the line in the break condition for the given duration
+func (p *serport) sendBreakCondition(duration time.Duration) {
+	err := p.portIo.Drain()
+	if err == nil {
+		err = p.portIo.Break(duration)
+	}
+	if err != nil {
+		errstr := "Error sending break to " + p.portConf.Name + " " + err.Error()
+		log.Print(errstr)
+		broadcastEvent(eventPortError, errstr)
+		return
+	}
+	broadcastEvent(eventPortBreak, map[string]interface{}{
+		"Cmd":      "Break",
+		"Desc":     "Sent break on port.",
+		"Port":     p.portConf.Name,
+		"Duration": duration.Milliseconds(),
+	})
+}
+
 // this method runs as its own thread because it's instantiated
 // as a "go" method. so if it blocks inside, it is ok
 func (p *serport) writerRaw() {
@@ -256,93 +454,123 @@ func (p *serport) writerRaw() {
 
 	// this for loop blocks on p.sendRaw until that channel
 	// sees something come in
-	for data := range p.sendRaw {
+	for w := range p.sendRaw {
 
 		// Decode stuff
-		sDec, err := base64.StdEncoding.DecodeString(data)
+		sDec, err := base64.StdEncoding.DecodeString(string(w.data))
 		if err != nil {
 			log.Println("Decoding error:", err)
+			p.writeComplete(w, 0, err)
+			continue
 		}
 		log.Println(string(sDec))
 
 		// send to the non-buffered serial port writer
-		p.sendNoBuf <- sDec
+		p.sendNoBuf <- serialWrite{data: sDec, id: w.id, conn: w.conn}
 
 	}
 	msgstr := "writerRaw just got closed. make sure you make a new one. port:" + p.portConf.Name
 	log.Println(msgstr)
-	h.broadcastSys <- []byte(msgstr)
+	broadcastEvent(eventPortClose, msgstr)
 }
 
 // This lock is used to prevent multiple threads from trying to open the same port at the same time.
 // It presents issues with the serial port driver on some OS's: https://github.com/arduino/arduino-create-agent/issues/1031
 var spHandlerOpenLock sync.Mutex
 
-func spHandlerOpen(portname string, baud int, buftype string) {
+func spHandlerOpen(conf *SerialConfig, buftype string) error {
 	spHandlerOpenLock.Lock()
 	defer spHandlerOpenLock.Unlock()
 
 	log.Print("Inside spHandler")
 
+	conf.Name = portAliases.Resolve(conf.Name)
+	portname := conf.Name
+
 	var out bytes.Buffer
 
 	out.WriteString("Opening serial port ")
 	out.WriteString(portname)
 	out.WriteString(" at ")
-	out.WriteString(strconv.Itoa(baud))
+	out.WriteString(strconv.Itoa(conf.Baud))
 	out.WriteString(" baud")
 	log.Print(out.String())
 
-	conf := &SerialConfig{Name: portname, Baud: baud, RtsOn: true}
+	if err := conf.Validate(); err != nil {
+		log.Print("Invalid port configuration " + err.Error())
+		spOpenFail(conf, "Invalid port configuration. "+err.Error())
+		return err
+	}
+
+	if serialPorts.IsFiltered(portname) {
+		log.Print("Port " + portname + " is filtered out")
+		spOpenFail(conf, "Port "+portname+" is filtered out by the allowPorts and denyPorts settings.")
+		return errors.New("port " + portname + " is filtered out")
+	}
 
-	mode := &serial.Mode{
-		BaudRate: baud,
+	algorithm, ok := getBufferflow(buftype)
+	if !ok {
+		log.Print("Unknown buffer algorithm " + buftype)
+		spOpenFail(conf, "Unknown buffer algorithm "+buftype+".")
+		return errors.New("unknown buffer algorithm " + buftype)
+	}
+	params, err := algorithm.Params(conf.BufferParams)
+	if err != nil {
+		log.Print("Invalid buffer parameters " + err.Error())
+		spOpenFail(conf, "Invalid buffer parameters. "+err.Error())
+		return err
 	}
 
-	sp, err := serial.Open(portname, mode)
+	var sp serial.Port
+	if strings.HasPrefix(portname, replayPortPrefix) {
+		sp, err = openReplayPort(portname, func() {
+			broadcastEvent(eventPortRecord, SpPortEvent{Cmd: "ReplayDone", Desc: "Reached the end of the recording.", Port: portname})
+		})
+	} else {
+		sp, err = openSerialPort(conf)
+	}
 	log.Print("Just tried to open port")
 	if err != nil {
 		existingPort, ok := sh.FindPortByName(portname)
-		if ok && existingPort.portConf.Baud == baud && existingPort.BufferType == buftype {
+		if ok && existingPort.portConf.SameMode(conf) && existingPort.BufferType == buftype {
 			log.Print("Port already opened")
-			h.broadcastSys <- []byte("{\"Cmd\":\"Open\",\"Desc\":\"Port already opened.\",\"Port\":\"" + existingPort.portConf.Name + "\",\"Baud\":" + strconv.Itoa(existingPort.portConf.Baud) + ",\"BufferType\":\"" + existingPort.BufferType + "\"}")
-		} else {
-			log.Print("Error opening port " + err.Error())
-			h.broadcastSys <- []byte("{\"Cmd\":\"OpenFail\",\"Desc\":\"Error opening port. " + err.Error() + "\",\"Port\":\"" + conf.Name + "\",\"Baud\":" + strconv.Itoa(conf.Baud) + "}")
+			broadcastEvent(eventPortOpen, newSpPortModeEvent("Open", "Port already opened.", existingPort.portConf, existingPort.BufferType))
+			return nil
 		}
-		return
+		// tell who is using the port, the most common reason of failure
+		holders := findPortHolders(portname)
+		if len(holders) > 0 {
+			err = fmt.Errorf("%w. The port is in use by %s", err, describePortHolders(holders))
+		}
+		log.Print("Error opening port " + err.Error())
+		spOpenFail(conf, "Error opening port. "+err.Error(), holders...)
+		return err
 	}
 	log.Print("Opened port successfully")
 	//p := &serport{send: make(chan []byte, 256), portConf: conf, portIo: sp}
 	// we can go up to 256,000 lines of gcode in the buffer
 	p := &serport{
-		sendBuffered: make(chan string, 256000),
-		sendNoBuf:    make(chan []byte),
-		sendRaw:      make(chan string),
+		sendBuffered: make(chan serialWrite, 256000),
+		sendNoBuf:    make(chan serialWrite),
+		sendRaw:      make(chan serialWrite),
+		sendBreak:    make(chan time.Duration),
+		done:         make(chan struct{}),
 		portConf:     conf,
 		portIo:       sp,
 		portName:     portname,
 		BufferType:   buftype}
-
-	var bw Bufferflow
-
-	switch buftype {
-	case "timed":
-		bw = NewBufferflowTimed(portname, h.broadcastSys)
-	case "timedraw":
-		bw = NewBufferflowTimedRaw(portname, h.broadcastSys)
-	case "default":
-		bw = NewBufferflowDefault(portname, h.broadcastSys)
-	default:
-		log.Panicf("unknown buffer type: %s", buftype)
+	if conf.FlowControl == "xonxoff" {
+		p.xonxoff = newSoftwareFlowControl()
 	}
+	p.serialNumber, p.vendorID, p.productID = serialPorts.Identity(portname)
 
+	bw := algorithm.New(portname, h.broadcastPort, params)
 	bw.Init()
 	p.bufferwatcher = bw
 
 	sh.Register(p)
 
-	serialPorts.MarkPortAsOpened(portname)
+	serialPorts.MarkPortAsOpened(conf, buftype)
 	serialPorts.List()
 
 	// this is internally buffered thread to not send to serial port if blocked
@@ -351,17 +579,97 @@ func spHandlerOpen(portname string, baud int, buftype string) {
 	go p.writerNoBuf()
 	// this is thread to send to serial port but with base64 decoding
 	go p.writerRaw()
+	// this is the thread that reports the depth of the buffered queue
+	go p.queueReporter()
+	if conf.RFC2217Port != 0 {
+		if err := startRFC2217Server(portname, conf.RFC2217Port); err != nil {
+			spErr(err.Error())
+		}
+	}
 	// this is the thread that reads from the serial port
 	go func() {
-		p.reader(buftype)
+		p.reader(algorithm.SplitUTF8)
 		serialPorts.List()
 		sh.Unregister(p)
+		if p.isClosingDueToError && conf.AutoReconnect {
+			reconnector.Wait(p)
+		}
 	}()
+	return nil
+}
+
+// Config returns a copy of the configuration of the port, it can be changed and given to SetMode
+func (p *serport) Config() SerialConfig {
+	p.confLock.Lock()
+	defer p.confLock.Unlock()
+	conf := *p.portConf
+	conf.BufferParams = maps.Clone(conf.BufferParams)
+	return conf
+}
+
+// SetMode changes the line settings of the open port without closing it.
+// The reader and the writers keep running, so no queued data is lost.
+func (p *serport) SetMode(conf *SerialConfig) error {
+	p.confLock.Lock()
+	defer p.confLock.Unlock()
+
+	if conf.FlowControl != p.portConf.FlowControl {
+		return errors.New("flow control cannot be changed on an open port")
+	}
+	mode, err := conf.Mode()
+	if err != nil {
+		return err
+	}
+	if err := p.portIo.SetMode(mode); err != nil {
+		return err
+	}
+	p.portConf.Baud = conf.Baud
+	p.portConf.DataBits = conf.DataBits
+	p.portConf.Parity = conf.Parity
+	p.portConf.StopBits = conf.StopBits
+	return nil
+}
+
+// StartRecording starts saving the traffic of the port to a new file in the logs dir
+func (p *serport) StartRecording() (*serialRecorder, error) {
+	recorder, err := newSerialRecorder(p.portName)
+	if err != nil {
+		return nil, err
+	}
+	if !p.recorder.CompareAndSwap(nil, recorder) {
+		recorder.Close()
+		os.Remove(recorder.Name())
+		return nil, errors.New("the port is already being recorded")
+	}
+	return recorder, nil
+}
+
+// StopRecording stops the recording of the port, if any, and returns it
+func (p *serport) StopRecording() *serialRecorder {
+	recorder := p.recorder.Swap(nil)
+	if recorder != nil {
+		recorder.Close()
+	}
+	return recorder
+}
+
+func (p *serport) record(dir string, data []byte) {
+	if recorder := p.recorder.Load(); recorder != nil {
+		if err := recorder.Record(dir, data); err != nil {
+			log.Println("Error recording " + p.portName + ": " + err.Error())
+		}
+	}
 }
 
 func (p *serport) Close() {
 	p.isClosing.Store(true)
+	p.stop()
 
+	p.UnwatchModemStatus()
+	p.StopRecording()
+	if p.xonxoff != nil {
+		p.xonxoff.Close()
+	}
 	p.bufferwatcher.Close()
 	p.portIo.Close()
 	serialPorts.MarkPortAsClosed(p.portName)
@@ -0,0 +1,40 @@
+// Copyright 2026 Arduino SA
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+//go:build !linux && !darwin
+
+package main
+
+import (
+	"errors"
+
+	serial "go.bug.st/serial"
+)
+
+// serialOnePointFiveStopBits tells that the driver supports 1.5 stop bits with 5 data bits,
+// and rejects 2 stop bits with them
+const serialOnePointFiveStopBits = true
+
+// openSerialPort opens the port described by conf
+func openSerialPort(conf *SerialConfig) (serial.Port, error) {
+	mode, err := conf.Mode()
+	if err != nil {
+		return nil, err
+	}
+	if conf.FlowControl == "rtscts" {
+		return nil, errors.New("hardware flow control is not supported on this platform")
+	}
+	return serial.Open(conf.Name, mode)
+}
@@ -0,0 +1,129 @@
+// Copyright 2026 Arduino SA
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// modemStatusPollInterval is how often the modem status bits are read while watched
+const modemStatusPollInterval = 50 * time.Millisecond
+
+// SpModemStatus is the state of the modem lines of an open serial port
+type SpModemStatus struct {
+	Cmd  string
+	Port string
+	DTR  bool // DataTerminalReady, set by us
+	RTS  bool // RequestToSend, set by us
+	CTS  bool // ClearToSend, set by the device
+	DSR  bool // DataSetReady, set by the device
+	RI   bool // RingIndicator, set by the device
+	DCD  bool // DataCarrierDetect, set by the device
+}
+
+// SetDTR sets the DataTerminalReady line of the port
+func (p *serport) SetDTR(on bool) error {
+	p.confLock.Lock()
+	defer p.confLock.Unlock()
+	if err := p.portIo.SetDTR(on); err != nil {
+		return err
+	}
+	p.portConf.DtrOn = on
+	return nil
+}
+
+// SetRTS sets the RequestToSend line of the port
+func (p *serport) SetRTS(on bool) error {
+	p.confLock.Lock()
+	defer p.confLock.Unlock()
+	if err := p.portIo.SetRTS(on); err != nil {
+		return err
+	}
+	p.portConf.RtsOn = on
+	return nil
+}
+
+// ModemStatus reads the modem status bits of the port
+func (p *serport) ModemStatus() (*SpModemStatus, error) {
+	p.confLock.Lock()
+	defer p.confLock.Unlock()
+	bits, err := p.portIo.GetModemStatusBits()
+	if err != nil {
+		return nil, err
+	}
+	return &SpModemStatus{
+		Cmd:  "ModemStatus",
+		Port: p.portName,
+		DTR:  p.portConf.DtrOn,
+		RTS:  p.portConf.RtsOn,
+		CTS:  bits.CTS,
+		DSR:  bits.DSR,
+		RI:   bits.RI,
+		DCD:  bits.DCD,
+	}, nil
+}
+
+// WatchModemStatus starts polling the modem status bits, a ModemStatus
+// message is broadcasted every time one of the lines changes.
+// It returns false if the port was already being watched.
+func (p *serport) WatchModemStatus() bool {
+	p.confLock.Lock()
+	defer p.confLock.Unlock()
+	if p.modemWatcherDone != nil {
+		return false
+	}
+	p.modemWatcherDone = make(chan bool)
+	go p.modemWatcher(p.modemWatcherDone)
+	return true
+}
+
+// UnwatchModemStatus stops polling the modem status bits
+func (p *serport) UnwatchModemStatus() {
+	p.confLock.Lock()
+	defer p.confLock.Unlock()
+	if p.modemWatcherDone != nil {
+		close(p.modemWatcherDone)
+		p.modemWatcherDone = nil
+	}
+}
+
+func (p *serport) modemWatcher(done chan bool) {
+	ticker := time.NewTicker(modemStatusPollInterval)
+	defer ticker.Stop()
+
+	var last *SpModemStatus
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			if p.isClosing.Load() {
+				return
+			}
+			status, err := p.ModemStatus()
+			if err != nil {
+				log.Println("Error reading modem status bits on " + p.portName + ": " + err.Error())
+				continue
+			}
+			if last == nil || *last != *status {
+				broadcastEvent(eventPortModem, status)
+			}
+			last = status
+		}
+	}
+}
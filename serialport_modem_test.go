@@ -0,0 +1,153 @@
+// Copyright 2026 Arduino SA
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	serial "go.bug.st/serial"
+)
+
+// modemPort is a serial.Port that records the modem lines set and reports the given status bits
+type modemPort struct {
+	mu   sync.Mutex
+	dtr  []bool
+	rts  []bool
+	bits serial.ModemStatusBits
+}
+
+func (m *modemPort) Read(p []byte) (int, error)           { select {} }
+func (m *modemPort) Write(p []byte) (int, error)          { return len(p), nil }
+func (m *modemPort) Close() error                         { return nil }
+func (m *modemPort) SetMode(mode *serial.Mode) error      { return nil }
+func (m *modemPort) Drain() error                         { return nil }
+func (m *modemPort) ResetInputBuffer() error              { return nil }
+func (m *modemPort) ResetOutputBuffer() error             { return nil }
+func (m *modemPort) SetReadTimeout(t time.Duration) error { return nil }
+func (m *modemPort) Break(t time.Duration) error          { return nil }
+
+func (m *modemPort) SetDTR(dtr bool) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.dtr = append(m.dtr, dtr)
+	return nil
+}
+
+func (m *modemPort) SetRTS(rts bool) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.rts = append(m.rts, rts)
+	return nil
+}
+
+func (m *modemPort) setBits(bits serial.ModemStatusBits) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.bits = bits
+}
+
+func (m *modemPort) GetModemStatusBits() (*serial.ModemStatusBits, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	bits := m.bits
+	return &bits, nil
+}
+
+// modemEvents returns the ModemStatus events broadcasted so far
+func modemEvents() []string {
+	var msgs []string
+	for {
+		select {
+		case e := <-h.broadcastSys:
+			if e.Type == eventPortModem {
+				msgs = append(msgs, string(e.encode(false)))
+			}
+		default:
+			return msgs
+		}
+	}
+}
+
+func TestModemLines(t *testing.T) {
+	drainEvents(h.broadcastSys)
+	for len(h.reply) > 0 {
+		<-h.reply
+	}
+	io := &modemPort{bits: serial.ModemStatusBits{CTS: true, DCD: true}}
+	p := &serport{
+		portName:     "/dev/ttyMODEM",
+		portConf:     newSerialConfig("/dev/ttyMODEM", 9600),
+		portIo:       io,
+		sendBuffered: make(chan serialWrite),
+		sendNoBuf:    make(chan serialWrite),
+		done:         make(chan struct{}),
+	}
+	sh.Register(p)
+	defer sh.Unregister(p)
+	c := &connection{send: make(chan []byte, 10)}
+
+	spSetModemLine(c, "setdtr /dev/ttyMODEM off")
+	spSetModemLine(c, "setrts /dev/ttyMODEM on")
+	require.Equal(t, []bool{false}, io.dtr)
+	require.Equal(t, []bool{true}, io.rts)
+	// every change of the lines is notified
+	status := `{"Cmd":"ModemStatus","Port":"/dev/ttyMODEM","DTR":false,"RTS":true,"CTS":true,"DSR":false,"RI":false,"DCD":true}`
+	require.Equal(t, []string{status, status}, modemEvents())
+
+	spModemStatus(c, "modemstatus /dev/ttyMODEM")
+	reply := <-h.reply
+	require.Equal(t, c, reply.conn)
+	require.Equal(t, status, string(reply.event.encode(false)))
+
+	spSetModemLine(c, "setdtr /dev/ttyMODEM maybe")
+	reply = <-h.reply
+	require.Equal(t, eventError, reply.event.Type)
+	require.Equal(t, []bool{false}, io.dtr)
+}
+
+func TestModemStatusWatcher(t *testing.T) {
+	drainEvents(h.broadcastSys)
+	io := &modemPort{bits: serial.ModemStatusBits{CTS: true}}
+	p := &serport{portName: "/dev/ttyMODEM", portConf: newSerialConfig("/dev/ttyMODEM", 9600), portIo: io}
+	require.True(t, p.WatchModemStatus())
+	require.False(t, p.WatchModemStatus())
+	defer p.UnwatchModemStatus()
+
+	// the first poll reports the current status
+	var events []string
+	require.Eventually(t, func() bool {
+		events = append(events, modemEvents()...)
+		return len(events) > 0
+	}, time.Second, 10*time.Millisecond)
+	require.Len(t, events, 1)
+	require.Contains(t, events[0], `"CTS":true,"DSR":false`)
+
+	// nothing is sent while the lines don't change
+	time.Sleep(5 * modemStatusPollInterval)
+	require.Empty(t, modemEvents())
+
+	io.setBits(serial.ModemStatusBits{CTS: true, DSR: true})
+	events = nil
+	require.Eventually(t, func() bool {
+		events = append(events, modemEvents()...)
+		return len(events) > 0
+	}, time.Second, 10*time.Millisecond)
+	require.Len(t, events, 1)
+	require.Contains(t, events[0], `"CTS":true,"DSR":true`)
+}
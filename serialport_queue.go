@@ -0,0 +1,82 @@
+// Copyright 2026 Arduino SA
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"errors"
+	"time"
+)
+
+// queueReportInterval is how often the queue depth is reported while it changes
+const queueReportInterval = 500 * time.Millisecond
+
+// SpQueueStatus is the status of the buffered write queue of a port
+type SpQueueStatus struct {
+	Cmd    string
+	Port   string
+	Items  int   // number of pending buffered writes
+	Bytes  int64 // size of the pending buffered writes
+	Paused bool  `json:",omitempty"` // the device has paused the writes with XOFF
+}
+
+// QueueStatus returns the status of the buffered write queue
+func (p *serport) QueueStatus() *SpQueueStatus {
+	return &SpQueueStatus{
+		Cmd:    "Queue",
+		Port:   p.portName,
+		Items:  len(p.sendBuffered),
+		Bytes:  p.sendBufferedBytes.Load(),
+		Paused: p.xonxoff != nil && p.xonxoff.Paused(),
+	}
+}
+
+// ClearQueue discards the pending buffered writes and returns how many were discarded.
+// The write in progress, if any, is completed. Discarded writes with an id get a
+// WriteComplete message with an error.
+func (p *serport) ClearQueue() (items int, bytes int64) {
+	for {
+		select {
+		case w, ok := <-p.sendBuffered:
+			if !ok {
+				return
+			}
+			p.sendBufferedBytes.Add(-int64(len(w.data)))
+			p.writeComplete(w, 0, errors.New("discarded by clearqueue"))
+			items++
+			bytes += int64(len(w.data))
+		default:
+			return
+		}
+	}
+}
+
+// queueReporter broadcasts the status of the queue every time it changes, until the port is closed
+func (p *serport) queueReporter() {
+	ticker := time.NewTicker(queueReportInterval)
+	defer ticker.Stop()
+
+	last := SpQueueStatus{}
+	for range ticker.C {
+		if p.isClosing.Load() {
+			return
+		}
+		status := p.QueueStatus()
+		if status.Items != last.Items || status.Bytes != last.Bytes || status.Paused != last.Paused {
+			broadcastEvent(eventPortQueue, status)
+			last = *status
+		}
+	}
+}
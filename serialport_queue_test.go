@@ -0,0 +1,82 @@
+// Copyright 2026 Arduino SA
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClearQueue(t *testing.T) {
+	p := &serport{portName: "/dev/ttyACM0", sendBuffered: make(chan serialWrite, 10)}
+	p.Write("G0 X0\n", "send", "", nil)
+	p.Write("G0 Y10\n", "send", "", nil)
+	require.Equal(t, &SpQueueStatus{Cmd: "Queue", Port: "/dev/ttyACM0", Items: 2, Bytes: 13}, p.QueueStatus())
+
+	items, bytes := p.ClearQueue()
+	require.Equal(t, 2, items)
+	require.Equal(t, int64(13), bytes)
+	require.Equal(t, &SpQueueStatus{Cmd: "Queue", Port: "/dev/ttyACM0"}, p.QueueStatus())
+}
+
+func TestClearQueueReportsDiscardedWrites(t *testing.T) {
+	drainEvents(h.broadcastSys)
+	for len(h.reply) > 0 {
+		<-h.reply
+	}
+	for len(h.broadcastPort) > 0 {
+		<-h.broadcastPort
+	}
+	c := &connection{send: make(chan []byte, 10)}
+	p := &serport{portName: "/dev/ttyACM0", sendBuffered: make(chan serialWrite, 10)}
+	p.Write("G0 X0\n", "send", "42", c)
+	p.Write("G0 Y10\n", "send", "", c)
+	p.Write("G0 Z1\n", "send", "43", nil)
+	p.ClearQueue()
+
+	// the sender gets the WriteComplete message
+	reply := <-h.reply
+	require.Equal(t, c, reply.conn)
+	require.Equal(t, `{"Cmd":"WriteComplete","Port":"/dev/ttyACM0","ID":"42","Bytes":0,"Error":"discarded by clearqueue"}`, string(reply.event.encode(false)))
+	require.Empty(t, h.reply)
+	require.Empty(t, drainEvents(h.broadcastSys))
+	// without a sender it goes to the subscribers of the port
+	m := <-h.broadcastPort
+	require.Equal(t, portMessage{"/dev/ttyACM0", eventPortWrite, []byte(`{"Cmd":"WriteComplete","Port":"/dev/ttyACM0","ID":"43","Bytes":0,"Error":"discarded by clearqueue"}`)}, m)
+}
+
+func TestWriteOnClosingPort(t *testing.T) {
+	p := &serport{
+		portName:     "/dev/ttyACM0",
+		sendBuffered: make(chan serialWrite, 1),
+		sendNoBuf:    make(chan serialWrite),
+		done:         make(chan struct{}),
+	}
+	require.NoError(t, p.Write("G0 X0\n", "send", "", nil))
+	require.Error(t, p.Write("G0 X0\n", "unknown", "", nil))
+
+	// nobody reads the writes, as when the writer has stopped
+	blocked := make(chan error)
+	go func() { blocked <- p.Write("?", "sendnobuf", "", nil) }()
+	go func() { blocked <- p.Write("G0 Y10\n", "send", "", nil) }()
+	p.closeWrites()
+	require.ErrorIs(t, <-blocked, errPortClosed)
+	require.ErrorIs(t, <-blocked, errPortClosed)
+	require.Equal(t, 6, int(p.QueueStatus().Bytes))
+
+	require.ErrorIs(t, p.Write("G0 X0\n", "sendnobuf", "", nil), errPortClosed)
+}
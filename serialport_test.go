@@ -0,0 +1,174 @@
+// Copyright 2026 Arduino SA
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	serial "go.bug.st/serial"
+)
+
+// opsPort is a serial.Port that records the operations done on the line, writes take writeDelay
+type opsPort struct {
+	modemPort
+	ops        []string
+	writeDelay time.Duration
+}
+
+func (o *opsPort) Write(p []byte) (int, error) {
+	time.Sleep(o.writeDelay)
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.ops = append(o.ops, "write "+string(p))
+	return len(p), nil
+}
+
+func (o *opsPort) Drain() error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.ops = append(o.ops, "drain")
+	return nil
+}
+
+func (o *opsPort) Break(d time.Duration) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.ops = append(o.ops, "break "+d.String())
+	return nil
+}
+
+func TestBreakBetweenWrites(t *testing.T) {
+	io := &opsPort{writeDelay: 50 * time.Millisecond}
+	p := &serport{
+		portName:     "/dev/ttyACM0",
+		portConf:     newSerialConfig("/dev/ttyACM0", 9600),
+		portIo:       io,
+		sendBuffered: make(chan serialWrite),
+		sendNoBuf:    make(chan serialWrite),
+		sendBreak:    make(chan time.Duration),
+		done:         make(chan struct{}),
+	}
+	writerDone := make(chan bool)
+	go func() {
+		p.writerNoBuf()
+		close(writerDone)
+	}()
+
+	// the break waits for the first write to be sent, and the second one waits for the break
+	require.NoError(t, p.Write("G0 X0\n", "sendnobuf", "", nil))
+	require.NoError(t, p.Break(250*time.Millisecond))
+	require.NoError(t, p.Write("G0 Y10\n", "sendnobuf", "", nil))
+	p.closeWrites()
+	<-writerDone
+	require.Equal(t, []string{"write G0 X0\n", "drain", "break 250ms", "write G0 Y10\n"}, io.ops)
+}
+
+func TestBreakOnClosedPort(t *testing.T) {
+	// the writer is not running, i.e. it has stopped
+	p := &serport{portName: "/dev/ttyACM0", sendBreak: make(chan time.Duration), done: make(chan struct{})}
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		p.stop()
+	}()
+	require.ErrorIs(t, p.Break(100*time.Millisecond), errPortClosed)
+	p.stop()
+}
+
+// modePort is a serial.Port that records the modes set, or fails to set them with err
+type modePort struct {
+	modemPort
+	modes []serial.Mode
+	err   error
+}
+
+func (m *modePort) SetMode(mode *serial.Mode) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.modes = append(m.modes, *mode)
+	return nil
+}
+
+func TestSetMode(t *testing.T) {
+	serialPorts.portsLock.Lock()
+	serialPorts.Ports = []*SpPortItem{{Name: "/dev/ttyMODE"}}
+	serialPorts.portsLock.Unlock()
+	defer func() {
+		serialPorts.portsLock.Lock()
+		serialPorts.Ports = nil
+		serialPorts.portsLock.Unlock()
+	}()
+	listed := func() SpPortItem {
+		serialPorts.portsLock.Lock()
+		defer serialPorts.portsLock.Unlock()
+		return *serialPorts.Ports[0]
+	}
+
+	io := &modePort{}
+	conf := newSerialConfig("/dev/ttyMODE", 9600)
+	p := &serport{
+		portName:     "/dev/ttyMODE",
+		portConf:     conf,
+		portIo:       io,
+		BufferType:   "default",
+		sendBuffered: make(chan serialWrite),
+		sendNoBuf:    make(chan serialWrite),
+		done:         make(chan struct{}),
+	}
+	serialPorts.MarkPortAsOpened(conf, p.BufferType)
+	sh.Register(p)
+	defer sh.Unregister(p)
+	for len(h.reply) > 0 {
+		<-h.reply
+	}
+	c := &connection{send: make(chan []byte, 10)}
+
+	spSetMode(c, "setmode /dev/ttyMODE 115200 parity=even stopbits=2")
+	require.Empty(t, h.reply)
+	require.Equal(t, []serial.Mode{{BaudRate: 115200, DataBits: 8, Parity: serial.EvenParity, StopBits: serial.TwoStopBits}}, io.modes)
+	changed := p.Config()
+	require.Equal(t, 115200, changed.Baud)
+	require.Equal(t, "even", changed.Parity)
+	require.Equal(t, "2", changed.StopBits)
+	item := listed()
+	require.True(t, item.IsOpen)
+	require.Equal(t, 115200, item.Baud)
+	require.Equal(t, "even", item.Parity)
+	require.Equal(t, "2", item.StopBits)
+
+	// the changes that can't be applied leave the port untouched
+	for _, cmd := range []string{
+		"setmode /dev/ttyMODE 9600 databits=9",
+		"setmode /dev/ttyMODE 9600 interval=5",
+		"setmode /dev/ttyMODE 9600 flowcontrol=rtscts",
+		"setmode /dev/ttyMODE 0",
+	} {
+		spSetMode(c, cmd)
+		reply := <-h.reply
+		require.Equal(t, eventError, reply.event.Type, cmd)
+	}
+	io.err = errors.New("unsupported baud rate")
+	spSetMode(c, "setmode /dev/ttyMODE 12345")
+	reply := <-h.reply
+	require.Contains(t, string(reply.event.encode(false)), "unsupported baud rate")
+
+	require.Len(t, io.modes, 1)
+	require.Equal(t, changed, p.Config())
+	require.Equal(t, item, listed())
+}
@@ -0,0 +1,71 @@
+// Copyright 2026 Arduino SA
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+//go:build linux || darwin
+
+package main
+
+import (
+	"fmt"
+
+	serial "go.bug.st/serial"
+	"golang.org/x/sys/unix"
+)
+
+// termios has no setting for 1.5 stop bits: CSTOPB with 5 data bits is sent as 1.5 stop bits
+const serialOnePointFiveStopBits = false
+
+// openSerialPort opens the port described by conf.
+// go.bug.st/serial always disables RTS/CTS while opening and then grabs exclusive access
+// to the tty, so when hardware flow control is requested we keep a second descriptor open
+// on the same device and use it to change the termios flags, which are shared by the tty.
+func openSerialPort(conf *SerialConfig) (serial.Port, error) {
+	mode, err := conf.Mode()
+	if err != nil {
+		return nil, err
+	}
+	if conf.FlowControl != "rtscts" {
+		return serial.Open(conf.Name, mode)
+	}
+
+	fd, err := unix.Open(conf.Name, unix.O_RDWR|unix.O_NOCTTY|unix.O_NONBLOCK, 0)
+	if err != nil {
+		return nil, err
+	}
+	defer unix.Close(fd)
+
+	p, err := serial.Open(conf.Name, mode)
+	if err != nil {
+		return nil, err
+	}
+	if err := setHardwareFlowControl(fd, true); err != nil {
+		p.Close()
+		return nil, fmt.Errorf("cannot enable hardware flow control: %w", err)
+	}
+	return p, nil
+}
+
+func setHardwareFlowControl(fd int, enable bool) error {
+	termios, err := unix.IoctlGetTermios(fd, ioctlGetTermios)
+	if err != nil {
+		return err
+	}
+	if enable {
+		termios.Cflag |= unix.CRTSCTS
+	} else {
+		termios.Cflag &^= unix.CRTSCTS
+	}
+	return unix.IoctlSetTermios(fd, ioctlSetTermios, termios)
+}
@@ -0,0 +1,83 @@
+// Copyright 2022 Arduino SA
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import "sync"
+
+// the bytes sent by the device to pause and resume the writes
+const (
+	xonByte  = 0x11
+	xoffByte = 0x13
+)
+
+// softwareFlowControl pauses the writes to the port while the device has sent XOFF
+type softwareFlowControl struct {
+	mu     sync.Mutex
+	cond   *sync.Cond
+	paused bool
+	closed bool
+}
+
+func newSoftwareFlowControl() *softwareFlowControl {
+	f := &softwareFlowControl{}
+	f.cond = sync.NewCond(&f.mu)
+	return f
+}
+
+// Filter updates the state with the XON and XOFF bytes found in the data
+// read from the port and returns the data without them. The data is modified in place.
+func (f *softwareFlowControl) Filter(data []byte) []byte {
+	res := data[:0]
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, c := range data {
+		switch c {
+		case xonByte:
+			f.paused = false
+			f.cond.Broadcast()
+		case xoffByte:
+			f.paused = true
+		default:
+			res = append(res, c)
+		}
+	}
+	return res
+}
+
+// Paused tells if the device has paused the writes
+func (f *softwareFlowControl) Paused() bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.paused
+}
+
+// Wait blocks while the writes are paused. It returns false if the port has been closed.
+func (f *softwareFlowControl) Wait() bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for f.paused && !f.closed {
+		f.cond.Wait()
+	}
+	return !f.closed
+}
+
+// Close releases the pending writes
+func (f *softwareFlowControl) Close() {
+	f.mu.Lock()
+	f.closed = true
+	f.cond.Broadcast()
+	f.mu.Unlock()
+}
@@ -0,0 +1,52 @@
+// Copyright 2022 Arduino SA
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSoftwareFlowControl(t *testing.T) {
+	f := newSoftwareFlowControl()
+	p := &serport{portName: "/dev/ttyACM0", sendBuffered: make(chan serialWrite), xonxoff: f}
+
+	require.Equal(t, []byte("ok\n"), f.Filter([]byte("o\x13k\n")))
+	require.True(t, f.Paused())
+	require.True(t, p.QueueStatus().Paused)
+
+	resumed := make(chan bool)
+	go func() { resumed <- f.Wait() }()
+	select {
+	case <-resumed:
+		require.Fail(t, "the writes have not been paused")
+	case <-time.After(50 * time.Millisecond):
+	}
+	require.Empty(t, f.Filter([]byte{xonByte}))
+	require.True(t, <-resumed)
+	require.False(t, p.QueueStatus().Paused)
+
+	// the last byte wins
+	require.Equal(t, []byte("ab"), f.Filter([]byte("a\x11\x13b")))
+	require.True(t, f.Paused())
+
+	// closing releases the pending writes
+	go func() { resumed <- f.Wait() }()
+	f.Close()
+	require.False(t, <-resumed)
+}
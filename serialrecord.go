@@ -0,0 +1,181 @@
+// Copyright 2026 Arduino SA
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/arduino/arduino-create-agent/config"
+	"github.com/arduino/arduino-create-agent/utilities"
+	serial "go.bug.st/serial"
+)
+
+// replayPortPrefix is the prefix of the port names that replay a recording
+const replayPortPrefix = "replay:"
+
+// recordEntry is a single chunk of data read from or written to a serial port
+type recordEntry struct {
+	Time time.Time `json:"t"`
+	Dir  string    `json:"dir"` // "read" or "write"
+	Data []byte    `json:"data"`
+}
+
+// serialRecorder saves the traffic of a serial port to a file, one JSON entry per line
+type serialRecorder struct {
+	file    *os.File
+	encoder *json.Encoder
+	mu      sync.Mutex
+}
+
+var unsafeFileNameChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// maxRecordingsPerSecond limits the files created for a port in the same second
+const maxRecordingsPerSecond = 100
+
+// newSerialRecorder creates a new recording file for portname in the logs dir.
+// The recordings started in the same second get a counter after the time.
+func newSerialRecorder(portname string) (*serialRecorder, error) {
+	prefix := "record_" + strings.Trim(unsafeFileNameChars.ReplaceAllString(portname, "_"), "_") + "_" + time.Now().Format("20060102150405")
+	for i := 0; ; i++ {
+		name := prefix + ".jsonl"
+		if i > 0 {
+			name = prefix + "_" + strconv.Itoa(i) + ".jsonl"
+		}
+		file, err := os.OpenFile(config.GetLogsDir().Join(name).String(), os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+		if errors.Is(err, fs.ErrExist) && i < maxRecordingsPerSecond {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		return &serialRecorder{file: file, encoder: json.NewEncoder(file)}, nil
+	}
+}
+
+// Name returns the name of the recording file
+func (r *serialRecorder) Name() string {
+	return r.file.Name()
+}
+
+// Record appends data to the recording
+func (r *serialRecorder) Record(dir string, data []byte) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.encoder.Encode(recordEntry{Time: time.Now(), Dir: dir, Data: data})
+}
+
+// Close closes the recording file
+func (r *serialRecorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.file.Close()
+}
+
+// replayPort is a serial.Port that returns the data read in a recording,
+// respecting the original timing. Writes are discarded.
+type replayPort struct {
+	file    *os.File
+	decoder *json.Decoder
+	pending []byte
+	start   time.Time
+	first   time.Time
+	closing chan bool
+	once    sync.Once
+	onDone  func()
+}
+
+// openReplayPort opens the recording named after the replay: prefix of portname.
+// The recording is searched in the logs dir.
+func openReplayPort(portname string, onDone func()) (*replayPort, error) {
+	name := strings.TrimPrefix(portname, replayPortPrefix)
+	path, err := utilities.SafeJoin(config.GetLogsDir().String(), name)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	return &replayPort{
+		file:    file,
+		decoder: json.NewDecoder(file),
+		closing: make(chan bool),
+		onDone:  onDone,
+	}, nil
+}
+
+func (r *replayPort) Read(p []byte) (int, error) {
+	for len(r.pending) == 0 {
+		var entry recordEntry
+		if err := r.decoder.Decode(&entry); err != nil {
+			// the recording is over, behave like a quiet device until closed
+			if r.onDone != nil {
+				r.onDone()
+				r.onDone = nil
+			}
+			<-r.closing
+			return 0, io.EOF
+		}
+		if entry.Dir != "read" {
+			continue
+		}
+		if r.start.IsZero() {
+			r.start, r.first = time.Now(), entry.Time
+		}
+		select {
+		case <-time.After(time.Until(r.start.Add(entry.Time.Sub(r.first)))):
+		case <-r.closing:
+			return 0, io.EOF
+		}
+		r.pending = entry.Data
+	}
+	n := copy(p, r.pending)
+	r.pending = r.pending[n:]
+	return n, nil
+}
+
+func (r *replayPort) Write(p []byte) (int, error) {
+	return len(p), nil
+}
+
+func (r *replayPort) Close() error {
+	r.once.Do(func() {
+		close(r.closing)
+		r.file.Close()
+	})
+	return nil
+}
+
+func (r *replayPort) SetMode(mode *serial.Mode) error      { return nil }
+func (r *replayPort) Drain() error                         { return nil }
+func (r *replayPort) ResetInputBuffer() error              { return nil }
+func (r *replayPort) ResetOutputBuffer() error             { return nil }
+func (r *replayPort) SetDTR(dtr bool) error                { return nil }
+func (r *replayPort) SetRTS(rts bool) error                { return nil }
+func (r *replayPort) SetReadTimeout(t time.Duration) error { return errors.New("not supported") }
+func (r *replayPort) Break(t time.Duration) error          { return nil }
+func (r *replayPort) GetModemStatusBits() (*serial.ModemStatusBits, error) {
+	return &serial.ModemStatusBits{}, nil
+}
@@ -0,0 +1,67 @@
+// Copyright 2026 Arduino SA
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordAndReplay(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	recorder, err := newSerialRecorder("/dev/ttyACM0")
+	require.NoError(t, err)
+	require.NoError(t, recorder.Record("read", []byte("hello ")))
+	require.NoError(t, recorder.Record("write", []byte("ignored")))
+	require.NoError(t, recorder.Record("read", []byte{0x00, 0xff}))
+	require.NoError(t, recorder.Close())
+	require.Contains(t, filepath.Base(recorder.Name()), "record_dev_ttyACM0_")
+
+	done := make(chan bool, 1)
+	replay, err := openReplayPort(replayPortPrefix+filepath.Base(recorder.Name()), func() { done <- true })
+	require.NoError(t, err)
+
+	var data []byte
+	buf := make([]byte, 4)
+	for len(data) < 8 {
+		n, err := replay.Read(buf)
+		require.NoError(t, err)
+		data = append(data, buf[:n]...)
+	}
+	require.Equal(t, append([]byte("hello "), 0x00, 0xff), data)
+
+	go replay.Read(buf)
+	<-done
+	require.NoError(t, replay.Close())
+
+	_, err = openReplayPort(replayPortPrefix+"../evil.jsonl", nil)
+	require.Error(t, err)
+}
+
+func TestRecordTwiceInTheSameSecond(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	first, err := newSerialRecorder("/dev/ttyACM0")
+	require.NoError(t, err)
+	defer first.Close()
+	second, err := newSerialRecorder("/dev/ttyACM0")
+	require.NoError(t, err)
+	defer second.Close()
+	require.NotEqual(t, first.Name(), second.Name())
+}
@@ -0,0 +1,248 @@
+// Copyright 2026 Arduino SA
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"strings"
+
+	"github.com/arduino/arduino-create-agent/gen/serial"
+)
+
+// serialService implements the serial service of the v2 API
+// on top of the ports managed by the agent
+type serialService struct{}
+
+// List returns the ports of the computer
+func (s *serialService) List(ctx context.Context) (serial.PortCollection, error) {
+	items := serialPorts.Items()
+	res := make(serial.PortCollection, len(items))
+	for i := range items {
+		res[i] = newSerialServicePort(&items[i])
+	}
+	return res, nil
+}
+
+// Show returns a single port of the computer
+func (s *serialService) Show(ctx context.Context, payload *serial.PortName) (*serial.Port, error) {
+	item, ok := serialPorts.Item(payload.Name)
+	if !ok {
+		return nil, serial.MakeNotFound(errors.New("port " + payload.Name + " not found"))
+	}
+	return newSerialServicePort(item), nil
+}
+
+// State returns the configuration and the runtime state of an open port
+func (s *serialService) State(ctx context.Context, payload *serial.PortName) (*serial.PortState, error) {
+	port, ok := sh.FindPortByName(payload.Name)
+	if !ok {
+		return nil, serial.MakeNotFound(errors.New("port " + payload.Name + " is not open"))
+	}
+	port.confLock.Lock()
+	res := &serial.PortState{
+		Name:          port.portName,
+		Baud:          port.portConf.Baud,
+		DataBits:      port.portConf.DataBits,
+		Parity:        port.portConf.Parity,
+		StopBits:      port.portConf.StopBits,
+		FlowControl:   port.portConf.FlowControl,
+		Buffer:        port.BufferType,
+		AutoReconnect: port.portConf.AutoReconnect,
+		Recording:     port.recorder.Load() != nil,
+	}
+	port.confLock.Unlock()
+
+	queue := port.QueueStatus()
+	res.QueueItems = queue.Items
+	res.QueueBytes = queue.Bytes
+	res.Paused = queue.Paused
+
+	// not every port can report the modem lines, i.e. replayed ones
+	if modem, err := port.ModemStatus(); err == nil {
+		res.Modem = &serial.ModemStatus{
+			Dtr: modem.DTR,
+			Rts: modem.RTS,
+			Cts: modem.CTS,
+			Dsr: modem.DSR,
+			Ri:  modem.RI,
+			Dcd: modem.DCD,
+		}
+	}
+	return res, nil
+}
+
+// Buffers returns the buffer algorithms that can be used to open a port
+func (s *serialService) Buffers(ctx context.Context) (serial.BufferAlgorithmCollection, error) {
+	algorithms := bufferflowAlgorithms()
+	res := make(serial.BufferAlgorithmCollection, len(algorithms))
+	for i, algorithm := range algorithms {
+		res[i] = &serial.BufferAlgorithm{
+			Name:        algorithm.Name,
+			Description: algorithm.Description,
+			Parameters:  make([]*serial.BufferParameter, len(algorithm.Parameters)),
+		}
+		for j, param := range algorithm.Parameters {
+			res[i].Parameters[j] = &serial.BufferParameter{
+				Name:        param.Name,
+				Description: param.Description,
+				Type:        param.Type,
+				Values:      param.Values,
+			}
+			if !strings.HasSuffix(param.Name, "*") {
+				res[i].Parameters[j].Default = &param.Default
+			}
+			if param.Type == bufferflowParamInteger {
+				res[i].Parameters[j].Unit = &param.Unit
+				res[i].Parameters[j].Minimum = &param.Minimum
+			}
+		}
+	}
+	return res, nil
+}
+
+// Open opens a port with the given configuration
+func (s *serialService) Open(ctx context.Context, payload *serial.OpenPayload) (*serial.Operation, error) {
+	conf := newSerialConfig(payload.Name, payload.Baud)
+	conf.DataBits = payload.DataBits
+	conf.Parity = payload.Parity
+	conf.StopBits = payload.StopBits
+	conf.FlowControl = payload.FlowControl
+	conf.AutoReconnect = payload.AutoReconnect
+	conf.BufferParams = payload.BufferParameters
+	if err := conf.Validate(); err != nil {
+		return nil, serial.MakeInvalid(err)
+	}
+	algorithm, ok := getBufferflow(payload.Buffer)
+	if !ok {
+		return nil, serial.MakeInvalid(errors.New("unknown buffer algorithm " + payload.Buffer))
+	}
+	if _, err := algorithm.Params(conf.BufferParams); err != nil {
+		return nil, serial.MakeInvalid(err)
+	}
+
+	if err := spHandlerOpen(conf, payload.Buffer); err != nil {
+		return nil, serial.MakeOpenFailed(err)
+	}
+	return &serial.Operation{Status: "ok"}, nil
+}
+
+// Close closes a port, or stops waiting for it to reconnect
+func (s *serialService) Close(ctx context.Context, payload *serial.PortName) (*serial.Operation, error) {
+	if !closePort(payload.Name) {
+		return nil, serial.MakeNotFound(errors.New("port " + payload.Name + " is not open"))
+	}
+	return &serial.Operation{Status: "ok"}, nil
+}
+
+// Write queues data to be written to an open port
+func (s *serialService) Write(ctx context.Context, payload *serial.WritePayload) (*serial.Operation, error) {
+	port, ok := sh.FindPortByName(payload.Name)
+	if !ok {
+		return nil, serial.MakeNotFound(errors.New("port " + payload.Name + " is not open"))
+	}
+	if payload.Mode == "sendraw" {
+		if _, err := base64.StdEncoding.DecodeString(payload.Data); err != nil {
+			return nil, serial.MakeInvalid(errors.New("data is not base64 encoded: " + err.Error()))
+		}
+	}
+	id := ""
+	if payload.ID != nil {
+		id = *payload.ID
+	}
+	if err := port.Write(payload.Data, payload.Mode, id, nil); err != nil {
+		return nil, serial.MakeNotFound(errors.New("port " + payload.Name + ": " + err.Error()))
+	}
+	return &serial.Operation{Status: "ok"}, nil
+}
+
+// Aliases returns the alias table
+func (s *serialService) Aliases(ctx context.Context) (serial.AliasCollection, error) {
+	aliases := portAliases.List()
+	res := make(serial.AliasCollection, len(aliases))
+	for i := range aliases {
+		res[i] = newSerialServiceAlias(&aliases[i])
+	}
+	return res, nil
+}
+
+// SetAlias gives an alias to the device connected to a port
+func (s *serialService) SetAlias(ctx context.Context, payload *serial.AliasPayload) (*serial.Alias, error) {
+	item, ok := serialPorts.Item(payload.Name)
+	if !ok {
+		return nil, serial.MakeNotFound(errors.New("port " + payload.Name + " not found"))
+	}
+	alias, err := portAliases.Set(payload.Alias, item)
+	if err != nil {
+		return nil, serial.MakeInvalid(err)
+	}
+	serialPorts.List()
+	return newSerialServiceAlias(alias), nil
+}
+
+// RemoveAlias deletes an alias
+func (s *serialService) RemoveAlias(ctx context.Context, payload *serial.RemoveAliasPayload) (*serial.Operation, error) {
+	found, err := portAliases.Remove(payload.Alias)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, serial.MakeNotFound(errors.New("alias " + payload.Alias + " not found"))
+	}
+	serialPorts.List()
+	return &serial.Operation{Status: "ok"}, nil
+}
+
+func newSerialServiceAlias(alias *portAlias) *serial.Alias {
+	res := &serial.Alias{
+		Alias:     alias.Alias,
+		VendorID:  alias.VendorID,
+		ProductID: alias.ProductID,
+	}
+	if alias.SerialNumber != "" {
+		res.SerialNumber = &alias.SerialNumber
+	}
+	return res
+}
+
+func newSerialServicePort(item *SpPortItem) *serial.Port {
+	res := &serial.Port{
+		Name:   item.Name,
+		IsOpen: item.IsOpen,
+	}
+	if item.Alias != "" {
+		res.Alias = &item.Alias
+	}
+	if item.SerialNumber != "" {
+		res.SerialNumber = &item.SerialNumber
+	}
+	if item.VendorID != "" {
+		res.VendorID = &item.VendorID
+	}
+	if item.ProductID != "" {
+		res.ProductID = &item.ProductID
+	}
+	if item.IsOpen {
+		res.Baud = &item.Baud
+		res.DataBits = &item.DataBits
+		res.Parity = &item.Parity
+		res.StopBits = &item.StopBits
+		res.FlowControl = &item.FlowControl
+		res.Buffer = &item.BufferAlgorithm
+	}
+	return res
+}
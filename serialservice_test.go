@@ -0,0 +1,166 @@
+// Copyright 2026 Arduino SA
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/arduino/arduino-create-agent/config"
+	"github.com/arduino/arduino-create-agent/globals"
+	"github.com/arduino/arduino-create-agent/utilities"
+	v2 "github.com/arduino/arduino-create-agent/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSerialService(t *testing.T) {
+	ts := httptest.NewServer(v2.Server(config.GetDataDir().String(), nil, utilities.MustParseRsaPublicKey([]byte(globals.ArduinoSignaturePubKey)), &serialService{}))
+	defer ts.Close()
+
+	serialPorts.portsLock.Lock()
+	serialPorts.Ports = []*SpPortItem{
+		{Name: "/dev/ttyACM0", SerialNumber: "12345", VendorID: "0x2341", ProductID: "0x0043"},
+		{Name: "/dev/ttyREST", IsOpen: true, Baud: 115200, DataBits: 8, Parity: "none", StopBits: "1", FlowControl: "none", BufferAlgorithm: "default"},
+	}
+	serialPorts.portsLock.Unlock()
+	defer func() {
+		serialPorts.portsLock.Lock()
+		serialPorts.Ports = nil
+		serialPorts.portsLock.Unlock()
+	}()
+
+	p := &serport{
+		portName:     "/dev/ttyREST",
+		portConf:     newSerialConfig("/dev/ttyREST", 115200),
+		portIo:       &replayPort{},
+		BufferType:   "default",
+		sendBuffered: make(chan serialWrite, 10),
+		sendNoBuf:    make(chan serialWrite),
+		done:         make(chan struct{}),
+	}
+	sh.Register(p)
+	defer sh.Unregister(p)
+
+	request := func(method, path, body string) (int, map[string]interface{}) {
+		req, err := http.NewRequest(method, ts.URL+path, strings.NewReader(body))
+		require.NoError(t, err)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		data, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		var res map[string]interface{}
+		if data[0] == '{' {
+			require.NoError(t, json.Unmarshal(data, &res))
+		}
+		return resp.StatusCode, res
+	}
+
+	t.Run("list", func(t *testing.T) {
+		resp, err := http.Get(ts.URL + "/v2/serial/ports")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		var ports []map[string]interface{}
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&ports))
+		require.Len(t, ports, 2)
+		require.Equal(t, map[string]interface{}{"name": "/dev/ttyACM0", "serial_number": "12345", "vendor_id": "0x2341", "product_id": "0x0043", "is_open": false}, ports[0])
+		require.Equal(t, true, ports[1]["is_open"])
+		require.Equal(t, 115200.0, ports[1]["baud"])
+	})
+
+	t.Run("show", func(t *testing.T) {
+		status, res := request("GET", "/v2/serial/port?name="+url.QueryEscape("/dev/ttyACM0"), "")
+		require.Equal(t, http.StatusOK, status)
+		require.Equal(t, "12345", res["serial_number"])
+
+		status, _ = request("GET", "/v2/serial/port?name=COM99", "")
+		require.Equal(t, http.StatusNotFound, status)
+	})
+
+	t.Run("state", func(t *testing.T) {
+		p.Write("G0 X0\n", "send", "", nil)
+		defer p.ClearQueue()
+		status, res := request("GET", "/v2/serial/port/state?name="+url.QueryEscape("/dev/ttyREST"), "")
+		require.Equal(t, http.StatusOK, status)
+		require.Equal(t, 115200.0, res["baud"])
+		require.Equal(t, "default", res["buffer"])
+		require.Equal(t, 1.0, res["queue_items"])
+		require.Equal(t, 6.0, res["queue_bytes"])
+		require.Equal(t, false, res["paused"])
+		require.Equal(t, map[string]interface{}{"dtr": true, "rts": true, "cts": false, "dsr": false, "ri": false, "dcd": false}, res["modem"])
+
+		status, _ = request("GET", "/v2/serial/port/state?name="+url.QueryEscape("/dev/ttyACM0"), "")
+		require.Equal(t, http.StatusNotFound, status)
+	})
+
+	t.Run("write", func(t *testing.T) {
+		status, res := request("POST", "/v2/serial/port/write", `{"name": "/dev/ttyREST", "data": "G0 Y10\n", "id": "7"}`)
+		require.Equal(t, http.StatusOK, status)
+		require.Equal(t, "ok", res["status"])
+		w := <-p.sendBuffered
+		p.sendBufferedBytes.Add(-int64(len(w.data)))
+		require.Equal(t, serialWrite{data: []byte("G0 Y10\n"), id: "7"}, w)
+
+		status, _ = request("POST", "/v2/serial/port/write", `{"name": "/dev/ttyREST", "data": "not base64!", "mode": "sendraw"}`)
+		require.Equal(t, http.StatusBadRequest, status)
+
+		status, _ = request("POST", "/v2/serial/port/write", `{"name": "/dev/ttyACM0", "data": "G0 Y10\n"}`)
+		require.Equal(t, http.StatusNotFound, status)
+	})
+
+	t.Run("open", func(t *testing.T) {
+		status, _ := request("POST", "/v2/serial/port/open", `{"name": "/dev/ttyACM0", "baud": 9600, "parity": "even", "data_bits": 6, "stop_bits": "1.5"}`)
+		require.Equal(t, http.StatusBadRequest, status)
+
+		status, _ = request("POST", "/v2/serial/port/open", `{"name": "/dev/ttyACM0", "baud": 9600, "buffer": "unknown"}`)
+		require.Equal(t, http.StatusBadRequest, status)
+
+		status, _ = request("POST", "/v2/serial/port/open", `{"name": "/dev/ttyACM0", "baud": 9600, "buffer": "timed", "buffer_parameters": {"interval": "0"}}`)
+		require.Equal(t, http.StatusBadRequest, status)
+	})
+
+	t.Run("buffers", func(t *testing.T) {
+		resp, err := http.Get(ts.URL + "/v2/serial/buffers")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		var buffers []map[string]interface{}
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&buffers))
+		require.Len(t, buffers, len(bufferflows))
+		require.Equal(t, "default", buffers[0]["name"])
+		require.Empty(t, buffers[0]["parameters"])
+		timed := slices.IndexFunc(buffers, func(b map[string]interface{}) bool { return b["name"] == "timed" })
+		require.NotEqual(t, -1, timed)
+		require.Equal(t, []interface{}{map[string]interface{}{
+			"name":        "interval",
+			"description": "How often the data read is sent",
+			"type":        "integer",
+			"default":     "16",
+			"unit":        "ms",
+			"minimum":     1.0,
+		}}, buffers[timed]["parameters"])
+	})
+
+	t.Run("close", func(t *testing.T) {
+		status, _ := request("POST", "/v2/serial/port/close", `{"name": "/dev/ttyACM0"}`)
+		require.Equal(t, http.StatusNotFound, status)
+	})
+}
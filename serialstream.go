@@ -0,0 +1,152 @@
+// Copyright 2026 Arduino SA
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/gorilla/websocket"
+	log "github.com/sirupsen/logrus"
+)
+
+// serialStream is a binary websocket attached to an open serial port
+type serialStream struct {
+	// Buffered channel of the data read from the port.
+	send chan []byte
+}
+
+// serialStreamList keeps track of the streams attached to each port
+type serialStreamList struct {
+	streams map[string]map[*serialStream]bool
+	mu      sync.Mutex
+}
+
+var serialStreams = serialStreamList{
+	streams: make(map[string]map[*serialStream]bool),
+}
+
+var streamUpgrader = websocket.Upgrader{
+	// the origin is already checked by the CORS middleware
+	CheckOrigin: func(r *http.Request) bool { return true },
+}
+
+func (sl *serialStreamList) add(portname string, s *serialStream) {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+	if sl.streams[portname] == nil {
+		sl.streams[portname] = make(map[*serialStream]bool)
+	}
+	sl.streams[portname][s] = true
+}
+
+// remove detaches the stream from the port, the caller must hold the lock
+func (sl *serialStreamList) remove(portname string, s *serialStream) {
+	if !sl.streams[portname][s] {
+		return
+	}
+	delete(sl.streams[portname], s)
+	if len(sl.streams[portname]) == 0 {
+		delete(sl.streams, portname)
+	}
+	close(s.send)
+}
+
+// Remove detaches the stream from the port
+func (sl *serialStreamList) Remove(portname string, s *serialStream) {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+	sl.remove(portname, s)
+}
+
+// Publish sends the data read from the port to its streams.
+// Streams that can't keep up are dropped.
+func (sl *serialStreamList) Publish(portname string, data []byte) {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+	if len(sl.streams[portname]) == 0 {
+		return
+	}
+	// the reader reuses its buffer
+	data = append([]byte(nil), data...)
+	for s := range sl.streams[portname] {
+		select {
+		case s.send <- data:
+		default:
+			log.Println("Dropping slow stream on port " + portname)
+			sl.remove(portname, s)
+		}
+	}
+}
+
+// CloseAll detaches all the streams from the port
+func (sl *serialStreamList) CloseAll(portname string) {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+	for s := range sl.streams[portname] {
+		sl.remove(portname, s)
+	}
+}
+
+func (s *serialStream) writer(ws *websocket.Conn) {
+	defer ws.Close()
+	for data := range s.send {
+		if err := ws.WriteMessage(websocket.BinaryMessage, data); err != nil {
+			return
+		}
+	}
+	ws.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, "port closed"))
+}
+
+// serialStreamHandler carries the raw data of an open serial port over a websocket:
+// the data read from the port is sent as binary messages and the messages received
+// are written to the port.
+// The port name, or its alias, must be path escaped, i.e. /v2/serial/%2Fdev%2FttyACM0/stream
+func serialStreamHandler(w http.ResponseWriter, r *http.Request) {
+	portname := portAliases.Resolve(r.PathValue("port"))
+	if _, ok := sh.FindPortByName(portname); !ok {
+		http.Error(w, "port "+portname+" is not open", http.StatusNotFound)
+		return
+	}
+
+	ws, err := streamUpgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// the upgrader already replied with an error
+		log.Println("Error upgrading stream on port " + portname + ": " + err.Error())
+		return
+	}
+
+	s := &serialStream{send: make(chan []byte, 256)}
+	serialStreams.add(portname, s)
+	defer serialStreams.Remove(portname, s)
+	go s.writer(ws)
+
+	for {
+		_, data, err := ws.ReadMessage()
+		if err != nil {
+			return
+		}
+		// the port may have been closed in the meantime
+		port, ok := sh.FindPortByName(portname)
+		if !ok {
+			return
+		}
+		if err := port.Write(string(data), "sendnobuf", "", nil); err != nil {
+			// the stream is closed with a close frame by the writer
+			return
+		}
+	}
+}
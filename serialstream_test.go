@@ -0,0 +1,112 @@
+// Copyright 2026 Arduino SA
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSerialStream(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v2/serial/{port}/stream", serialStreamHandler)
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+	streamURL := "ws" + strings.TrimPrefix(ts.URL, "http") + "/v2/serial/" + url.PathEscape("/dev/ttySTREAM") + "/stream"
+
+	// the port is not open
+	_, resp, err := websocket.DefaultDialer.Dial(streamURL, nil)
+	require.Error(t, err)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	p := &serport{
+		portName:     "/dev/ttySTREAM",
+		portConf:     newSerialConfig("/dev/ttySTREAM", 9600),
+		sendBuffered: make(chan serialWrite),
+		sendNoBuf:    make(chan serialWrite, 1),
+		done:         make(chan struct{}),
+	}
+	sh.Register(p)
+
+	ws, _, err := websocket.DefaultDialer.Dial(streamURL, nil)
+	require.NoError(t, err)
+	defer ws.Close()
+
+	// messages are written to the port as they are
+	require.NoError(t, ws.WriteMessage(websocket.BinaryMessage, []byte{0x00, 0xff, 0x10}))
+	select {
+	case w := <-p.sendNoBuf:
+		require.Equal(t, []byte{0x00, 0xff, 0x10}, w.data)
+	case <-time.After(time.Second):
+		require.Fail(t, "message not written to the port")
+	}
+
+	// data read from the port is sent as binary messages
+	require.Eventually(t, func() bool {
+		serialStreams.mu.Lock()
+		defer serialStreams.mu.Unlock()
+		return len(serialStreams.streams["/dev/ttySTREAM"]) == 1
+	}, time.Second, 10*time.Millisecond)
+	serialStreams.Publish("/dev/ttySTREAM", []byte{0xfe, 0x00})
+	msgType, data, err := ws.ReadMessage()
+	require.NoError(t, err)
+	require.Equal(t, websocket.BinaryMessage, msgType)
+	require.Equal(t, []byte{0xfe, 0x00}, data)
+
+	// the stream is closed with the port
+	sh.Unregister(p)
+	_, _, err = ws.ReadMessage()
+	require.True(t, websocket.IsCloseError(err, websocket.CloseNormalClosure))
+}
+
+func TestSerialStreamOnClosingPort(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v2/serial/{port}/stream", serialStreamHandler)
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+	streamURL := "ws" + strings.TrimPrefix(ts.URL, "http") + "/v2/serial/" + url.PathEscape("/dev/ttyCLOSING") + "/stream"
+
+	p := &serport{
+		portName:     "/dev/ttyCLOSING",
+		portConf:     newSerialConfig("/dev/ttyCLOSING", 9600),
+		sendBuffered: make(chan serialWrite),
+		sendNoBuf:    make(chan serialWrite),
+		done:         make(chan struct{}),
+	}
+	sh.Register(p)
+	defer func() {
+		sh.mu.Lock()
+		delete(sh.ports, p.portName)
+		sh.mu.Unlock()
+	}()
+
+	ws, _, err := websocket.DefaultDialer.Dial(streamURL, nil)
+	require.NoError(t, err)
+	defer ws.Close()
+
+	// the port is still registered, but it doesn't accept writes anymore
+	p.closeWrites()
+	require.NoError(t, ws.WriteMessage(websocket.BinaryMessage, []byte("G0\n")))
+	_, _, err = ws.ReadMessage()
+	require.True(t, websocket.IsCloseError(err, websocket.CloseNormalClosure), err)
+}
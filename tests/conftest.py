@@ -13,6 +13,7 @@
 # You should have received a copy of the GNU Affero General Public License
 # along with this program.  If not, see <https://www.gnu.org/licenses/>.
 
+import json
 import os
 import platform
 import signal
@@ -35,6 +36,9 @@ def agent(pytestconfig):
         # "ARDUINO_DOWNLOADS_DIR": downloads_dir,
         # "ARDUINO_SKETCHBOOK_DIR": data_dir,
     }
+    if platform.system() == "Linux":
+        # create a virtual port with an echo device, so the serial tests can run without a board
+        agent += " -additional-config " + str(Path(pytestconfig.rootdir) / "tests" / "testdata" / "virtual.ini")
     run_context = Context()
 
     runner = Local(run_context) # execute a command on the local filesystem
@@ -68,9 +72,19 @@ def socketio(base_url, agent):
     yield sio
     sio.disconnect()
 
-@pytest.fixture(scope="session")
-def serial_port():
-    return "/dev/ttyACM0" # maybe this could be enhanced by calling arduino-cli
+@pytest.fixture(scope="function")
+def serial_port(socketio, message):
+    if platform.system() != "Linux":
+        return "/dev/ttyACM0" # maybe this could be enhanced by calling arduino-cli
+    # on Linux the agent emulates the SerialEcho sketch on a virtual port
+    socketio.emit('command', 'list')
+    time.sleep(.2)
+    for msg in message:
+        if "\"Ports\"" in msg:
+            for port in json.loads(msg)["Ports"]:
+                if port["SerialNumber"].startswith("VIRTUAL"):
+                    return port["Name"]
+    pytest.fail("virtual serial port not found")
 
 @pytest.fixture(scope="session")
 def baudrate():
@@ -44,75 +44,76 @@ def test_list(socketio, message):
     assert any("Ports" in i for i in message)
 
 
-# NOTE run the following tests with a board connected to the PC
+# NOTE run the following tests with a board connected to the PC, on Linux a virtual port is used instead
 @pytest.mark.skipif(
-    running_on_ci(),
-    reason="VMs have no serial ports",
+    running_on_ci() and platform != "linux",
+    reason="VMs have no serial ports, virtual ports are only available on Linux",
 )
 def test_open_serial_default(socketio, serial_port, baudrate, message):
     general_open_serial(socketio, serial_port, baudrate, message, "default")
 
 
 @pytest.mark.skipif(
-    running_on_ci(),
-    reason="VMs have no serial ports",
+    running_on_ci() and platform != "linux",
+    reason="VMs have no serial ports, virtual ports are only available on Linux",
 )
 def test_open_serial_timed(socketio, serial_port, baudrate, message):
     general_open_serial(socketio, serial_port, baudrate, message, "timed")
 
 
 @pytest.mark.skipif(
-    running_on_ci(),
-    reason="VMs have no serial ports",
+    running_on_ci() and platform != "linux",
+    reason="VMs have no serial ports, virtual ports are only available on Linux",
 )
 def test_open_serial_timedraw(socketio, serial_port, baudrate, message):
     general_open_serial(socketio, serial_port, baudrate, message, "timedraw")
 
 
 # NOTE run the following tests with a board connected to the PC and with the sketch found in tests/testdata/SerialEcho.ino on it be sure to change serial_address in conftest.py
+# on Linux the virtual port emulates the SerialEcho sketch
 @pytest.mark.skipif(
-    running_on_ci(),
-    reason="VMs have no serial ports",
+    running_on_ci() and platform != "linux",
+    reason="VMs have no serial ports, virtual ports are only available on Linux",
 )
 def test_send_serial_default(socketio, close_port, serial_port, baudrate, message):
     general_send_serial(socketio, close_port, serial_port, baudrate, message, "default")
 
 
 @pytest.mark.skipif(
-    running_on_ci(),
-    reason="VMs have no serial ports",
+    running_on_ci() and platform != "linux",
+    reason="VMs have no serial ports, virtual ports are only available on Linux",
 )
 def test_send_serial_timed(socketio, close_port, serial_port, baudrate, message):
     general_send_serial(socketio, close_port, serial_port, baudrate, message, "timed")
 
 
 @pytest.mark.skipif(
-    running_on_ci(),
-    reason="VMs have no serial ports",
+    running_on_ci() and platform != "linux",
+    reason="VMs have no serial ports, virtual ports are only available on Linux",
 )
 def test_send_serial_timedraw(socketio, close_port, serial_port, baudrate, message):
     general_send_serial(socketio, close_port, serial_port, baudrate, message, "timedraw")
 
 
 @pytest.mark.skipif(
-    running_on_ci(),
-    reason="VMs have no serial ports",
+    running_on_ci() and platform != "linux",
+    reason="VMs have no serial ports, virtual ports are only available on Linux",
 )
 def test_send_emoji_serial_default(socketio, close_port, serial_port, baudrate, message):
     general_send_emoji_serial(socketio, close_port, serial_port, baudrate, message, "default")
 
 
 @pytest.mark.skipif(
-    running_on_ci(),
-    reason="VMs have no serial ports",
+    running_on_ci() and platform != "linux",
+    reason="VMs have no serial ports, virtual ports are only available on Linux",
 )
 def test_send_emoji_serial_timed(socketio, close_port, serial_port, baudrate, message):
     general_send_emoji_serial(socketio, close_port, serial_port, baudrate, message, "timed")
 
 
 @pytest.mark.skipif(
-    running_on_ci(),
-    reason="VMs have no serial ports",
+    running_on_ci() and platform != "linux",
+    reason="VMs have no serial ports, virtual ports are only available on Linux",
 )
 def test_send_emoji_serial_timedraw(socketio, close_port, serial_port, baudrate, message):
     general_send_emoji_serial(socketio, close_port, serial_port, baudrate, message, "timedraw")
@@ -172,8 +173,8 @@ def open_serial_port(socketio, serial_port, baudrate, message, buffertype):
 
 
 @pytest.mark.skipif(
-    running_on_ci(),
-    reason="VMs have no serial ports",
+    running_on_ci() and platform != "linux",
+    reason="VMs have no serial ports, virtual ports are only available on Linux",
 )
 def test_sendraw_serial(socketio, close_port, serial_port, baudrate, message):
     open_serial_port(socketio, serial_port, baudrate, message, "timedraw")
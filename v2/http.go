@@ -21,7 +21,9 @@ import (
 	"encoding/json"
 	"net/http"
 
+	serialsvr "github.com/arduino/arduino-create-agent/gen/http/serial/server"
 	toolssvr "github.com/arduino/arduino-create-agent/gen/http/tools/server"
+	serialsvc "github.com/arduino/arduino-create-agent/gen/serial"
 	toolssvc "github.com/arduino/arduino-create-agent/gen/tools"
 	"github.com/arduino/arduino-create-agent/index"
 	"github.com/arduino/arduino-create-agent/v2/pkgs"
@@ -31,8 +33,9 @@ import (
 	goamiddleware "goa.design/goa/v3/middleware"
 )
 
-// Server is the actual server
-func Server(directory string, index *index.Resource, pubKey *rsa.PublicKey) http.Handler {
+// Server is the actual server, the serial service is implemented by the caller
+// because it needs the ports managed by the agent
+func Server(directory string, index *index.Resource, pubKey *rsa.PublicKey, serialSvc serialsvc.Service) http.Handler {
 	mux := goahttp.NewMuxer()
 
 	// Instantiate logger
@@ -46,6 +49,11 @@ func Server(directory string, index *index.Resource, pubKey *rsa.PublicKey) http
 	toolsServer := toolssvr.New(toolsEndpoints, mux, CustomRequestDecoder, goahttp.ResponseEncoder, errorHandler(logger), nil)
 	toolssvr.Mount(mux, toolsServer)
 
+	// Mount serial
+	serialEndpoints := serialsvc.NewEndpoints(serialSvc)
+	serialServer := serialsvr.New(serialEndpoints, mux, CustomRequestDecoder, goahttp.ResponseEncoder, errorHandler(logger), nil)
+	serialsvr.Mount(mux, serialServer)
+
 	// Mount middlewares
 	handler := middleware.Log(logAdapter)(mux)
 	handler = middleware.RequestID()(handler)
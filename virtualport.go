@@ -0,0 +1,68 @@
+// Copyright 2026 Arduino SA
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// virtualDevice emulates the board connected to a virtual port, it runs
+// until rw is closed or returns an error
+type virtualDevice func(rw io.ReadWriter)
+
+// virtualDevices are the devices that can be attached to a virtual port
+var virtualDevices = map[string]virtualDevice{
+	"echo": echoDevice,
+}
+
+// echoDevice sends back everything it receives, like the SerialEcho sketch
+func echoDevice(rw io.ReadWriter) {
+	if _, err := io.Copy(rw, rw); err != nil {
+		log.Println("Echo device stopped: " + err.Error())
+	}
+}
+
+// The virtual ports are reported with the pid.codes test VID/PID
+const (
+	virtualPortVID = "0x1209"
+	virtualPortPID = "0x0001"
+)
+
+// createVirtualPorts creates a virtual port for each device in the
+// comma separated list, i.e. "echo,echo", and adds it to the port list
+func createVirtualPorts(devices string) error {
+	for _, name := range strings.Split(devices, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		device, ok := virtualDevices[name]
+		if !ok {
+			return fmt.Errorf("unknown virtual device %q", name)
+		}
+		portname, err := createVirtualPort(device)
+		if err != nil {
+			return err
+		}
+		serialPorts.addVirtual(portname, name)
+		log.Printf("Created virtual port %s with %s device", portname, name)
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+// Copyright 2026 Arduino SA
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"os"
+	"strconv"
+
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
+)
+
+// openPty creates a new pseudo-terminal pair and returns the master side
+// together with the path of the slave device, i.e. /dev/pts/3
+func openPty() (*os.File, string, error) {
+	fd, err := unix.Open("/dev/ptmx", unix.O_RDWR|unix.O_NOCTTY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		return nil, "", err
+	}
+	if err := unix.IoctlSetPointerInt(fd, unix.TIOCSPTLCK, 0); err != nil {
+		unix.Close(fd)
+		return nil, "", err
+	}
+	n, err := unix.IoctlGetInt(fd, unix.TIOCGPTN)
+	if err != nil {
+		unix.Close(fd)
+		return nil, "", err
+	}
+	return os.NewFile(uintptr(fd), "/dev/ptmx"), "/dev/pts/" + strconv.Itoa(n), nil
+}
+
+// createVirtualPort creates a pty pair and runs the given device on its master side.
+// The returned path is the slave side, that can be opened like any other serial port.
+func createVirtualPort(device virtualDevice) (string, error) {
+	master, slave, err := openPty()
+	if err != nil {
+		return "", err
+	}
+	// keep the slave open ourselves, otherwise the master side
+	// gets an EIO every time the last client closes the port
+	keepalive, err := os.OpenFile(slave, os.O_RDWR|unix.O_NOCTTY, 0)
+	if err != nil {
+		master.Close()
+		return "", err
+	}
+	// the line discipline of the master side must not alter the data
+	if err := setRawTermios(keepalive); err != nil {
+		keepalive.Close()
+		master.Close()
+		return "", err
+	}
+	go func() {
+		defer master.Close()
+		defer keepalive.Close()
+		device(master)
+		log.Println("Virtual device on " + slave + " stopped")
+	}()
+	return slave, nil
+}
+
+func setRawTermios(f *os.File) error {
+	termios, err := unix.IoctlGetTermios(int(f.Fd()), unix.TCGETS)
+	if err != nil {
+		return err
+	}
+	termios.Iflag &^= unix.IGNBRK | unix.BRKINT | unix.PARMRK | unix.ISTRIP | unix.INLCR | unix.IGNCR | unix.ICRNL | unix.IXON
+	termios.Oflag &^= unix.OPOST
+	termios.Lflag &^= unix.ECHO | unix.ECHONL | unix.ICANON | unix.ISIG | unix.IEXTEN
+	termios.Cflag &^= unix.CSIZE | unix.PARENB
+	termios.Cflag |= unix.CS8
+	termios.Cc[unix.VMIN] = 1
+	termios.Cc[unix.VTIME] = 0
+	return unix.IoctlSetTermios(int(f.Fd()), unix.TCSETS, termios)
+}
@@ -0,0 +1,46 @@
+// Copyright 2026 Arduino SA
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVirtualEchoPort(t *testing.T) {
+	portname, err := createVirtualPort(echoDevice)
+	require.NoError(t, err)
+
+	port, err := openSerialPort(newSerialConfig(portname, 9600))
+	require.NoError(t, err)
+	defer port.Close()
+	require.NoError(t, port.SetReadTimeout(time.Second))
+
+	_, err = port.Write([]byte("ciao\n🧀"))
+	require.NoError(t, err)
+
+	var data []byte
+	buf := make([]byte, 64)
+	for len(data) < 9 {
+		n, err := port.Read(buf)
+		require.NoError(t, err)
+		require.NotZero(t, n, "timeout waiting for the echo")
+		data = append(data, buf[:n]...)
+	}
+	require.Equal(t, "ciao\n🧀", string(data))
+}